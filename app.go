@@ -3,12 +3,17 @@ package gu
 import (
 	"fmt"
 	"html/template"
+	"io"
+	"strings"
+	"sync"
 
+	"github.com/gu-io/gu/common"
 	"github.com/gu-io/gu/drivers/core"
 	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/router"
 	"github.com/gu-io/gu/trees"
 	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
 )
 
 // NApp defines a struct which encapsulates all the core view management functions
@@ -21,23 +26,51 @@ type NApp struct {
 	views          []*NView
 	activeViews    []*NView
 	tree           *trees.Markup
-	notifications  *notifications.AppEventNotification
+	notifications  *notifications.Notifications
 	router         *router.Router
+	names          *router.Names
 	resourceHeader []*trees.Markup
 	resourceBody   []*trees.Markup
+
+	ids    trees.IDGenerator
+	idMeta *trees.Markup
+	kml    sync.Mutex
+	keys   int
+
+	// sml guards the scheduler state along with the list of views and their
+	// dependencies, which are read by flushes from other goroutines.
+	scheduler Scheduler
+	sml       sync.Mutex
+	dirty     map[*NView]bool
+	scheduled bool
+
+	enters      []router.Guard
+	leaves      []router.Guard
+	nml         sync.Mutex
+	navigations int
 }
 
+// maxRedirects defines the total redirects a navigation can follow before it
+// is cancelled.
+const maxRedirects = 10
+
 // App creates a new app structure to rendering gu components.
-func App(title string, router *router.Router) *NApp {
+func App(title string, routes *router.Router) *NApp {
 	var app NApp
 	app.title = title
 	app.uuid = NewKey()
-	app.router = router
-	app.notifications = notifications.AppNotification(app.uuid)
+	app.router = routes
+	app.names = router.NewNames()
+	app.notifications = notifications.New()
+	app.notifications.Notify(common.NewEventBroadcastHandler(app.handleEvent))
+	app.scheduler = ImmediateScheduler
+	app.dirty = make(map[*NView]bool)
+
+	app.idMeta = elems.Meta(trees.NewAttr("app-id", app.uuid))
 
 	var head []*trees.Markup
 	head = append(head, elems.Title(elems.Text(app.title)))
-	head = append(head, elems.Meta(trees.NewAttr("app-id", app.uuid)))
+	head = append(head, app.idMeta)
 	head = append(head, elems.Meta(trees.NewAttr("charset", "utf-8")))
 
 	app.resourceHeader = head
@@ -45,11 +78,318 @@ func App(title string, router *router.Router) *NApp {
 	return &app
 }
 
-// Navigate sets the giving app location and also sets the location of the
-// NOOPLocation which returns that always.
+// UseIDs sets the IDGenerator which gives the markup rendered by the app its
+// uids and hashes, in place of the random ones given by trees.NewMarkup. With
+// generators like trees.ContentIDs or trees.SequentialIDs, which is reset
+// before every full render, the html of the app is the same across renders,
+// apps and runs. The uuid of the app, which the keys of its views and
+// components are made from, is taken from the generator for the title of the
+// app, so UseIDs should be called before any view is added.
+func (app *NApp) UseIDs(gen trees.IDGenerator) {
+	app.ids = gen
+
+	root := trees.NewMarkup("app", false)
+	root.Key = app.title
+
+	app.uuid = gen.UID(root)
+	trees.ReplaceORAddAttribute(app.idMeta, "app-id", app.uuid)
+}
+
+// newKey returns a new key for a view or component of the app, made from the
+// app uuid and a count which increases with every key.
+func (app *NApp) newKey() string {
+	app.kml.Lock()
+	defer app.kml.Unlock()
+
+	app.keys++
+	return fmt.Sprintf("%d-%s", app.keys, app.uuid)
+}
+
+// resetIDs resets the IDGenerator of the app if it supports it, so a full
+// render starts from the same ids as the last one.
+func (app *NApp) resetIDs() {
+	if resetter, ok := app.ids.(interface {
+		Reset()
+	}); ok {
+		resetter.Reset()
+	}
+}
+
+// assignIDs gives the markup and its children the uids and hashes of the
+// IDGenerator of the app, if any, skipping the markup of views which gets its
+// ids when rendered.
+func (app *NApp) assignIDs(e *trees.Markup, views map[*trees.Markup]bool) {
+	if app.ids == nil || views[e] {
+		return
+	}
+
+	e.SwapUID(app.ids.UID(e))
+
+	for _, child := range e.Children() {
+		app.assignIDs(child, views)
+	}
+
+	e.SwapHash(app.ids.Hash(e))
+}
+
+// Navigate sets the giving app location, adding it to the history of the
+// Location of the app or replacing its current entry if the directive sets
+// Replace. An AppUpdate is then published for the drivers to render the views
+// of the new location.
+//
+// The navigation first goes through the guards of the app and of its views,
+// running the BeforeLeave guards of the views being left and then the
+// BeforeEnter guards of the views being entered. A guard can cancel the
+// navigation, which publishes a NavigationCancelled, or redirect it to
+// another path. Navigations started while the guards of an earlier one are
+// deciding replace it.
 func (app *NApp) Navigate(pe router.PushDirectiveEvent) {
 	app.initSanitCheck()
-	app.location.Navigate(pe)
+
+	app.navigate(app.nextNavigation(), pe, 0, nil)
+}
+
+// Load navigates the app to its initial location like Replace, through the
+// guards of the app and of its views, calling done once the navigation is
+// applied, redirected or cancelled, with true/false if the route of the
+// location was activated. Drivers use it to route the location a page is
+// requested with, rendering the app once done is called.
+func (app *NApp) Load(pe router.PushDirectiveEvent, done func(bool)) {
+	app.initSanitCheck()
+
+	pe.Replace = true
+	app.navigate(app.nextNavigation(), pe, 0, done)
+}
+
+// nextNavigation returns the id of a new navigation, replacing any earlier
+// one whose guards are still deciding.
+func (app *NApp) nextNavigation() int {
+	app.nml.Lock()
+	defer app.nml.Unlock()
+
+	app.navigations++
+	return app.navigations
+}
+
+// Replace navigates the app like Navigate, replacing the current entry of the
+// history of its Location instead of adding to it.
+func (app *NApp) Replace(pe router.PushDirectiveEvent) {
+	pe.Replace = true
+	app.Navigate(pe)
+}
+
+// Back moves the app to the previous entry of the history of its Location,
+// publishing an AppUpdate, returning false if there is none. The move goes
+// through the guards of the app and of its views like Navigate, where a
+// cancelled move leaves the history at its current entry and a redirected one
+// navigates to the path of the redirect.
+func (app *NApp) Back() bool {
+	return app.move(-1)
+}
+
+// Forward moves the app to the next entry of the history of its Location,
+// publishing an AppUpdate, returning false if there is none. The move goes
+// through the guards like Back.
+func (app *NApp) Forward() bool {
+	return app.move(1)
+}
+
+// move moves the history of the Location of the app by the giving step once
+// allowed by the guards, returning false if there is no entry to move to.
+// Locations which do not report the index of their current entry, like the
+// MemoryLocation does, are moved without running the guards.
+func (app *NApp) move(step int) bool {
+	app.initSanitCheck()
+
+	location := app.location
+	shift := func() bool {
+		if step < 0 {
+			return location.Back()
+		}
+
+		return location.Forward()
+	}
+
+	indexed, ok := location.(interface {
+		Index() int
+	})
+	if !ok {
+		if !shift() {
+			return false
+		}
+
+		app.notifications.Handle(AppUpdate{App: app})
+		return true
+	}
+
+	history := location.History()
+	index := indexed.Index() + step
+	if index < 0 || index >= len(history) {
+		return false
+	}
+
+	app.guard(app.nextNavigation(), history[index].Event, 0, false, nil, shift)
+
+	return true
+}
+
+// History returns the entries of the history of the Location of the app,
+// oldest first.
+func (app *NApp) History() []HistoryEntry {
+	app.initSanitCheck()
+	return app.location.History()
+}
+
+// BeforeEnter adds a Guard which decides on every navigation of the app,
+// before the guards of the views being entered.
+func (app *NApp) BeforeEnter(guard router.Guard) {
+	app.enters = append(app.enters, guard)
+}
+
+// BeforeLeave adds a Guard which decides on every navigation of the app,
+// before the guards of the views being left.
+func (app *NApp) BeforeLeave(guard router.Guard) {
+	app.leaves = append(app.leaves, guard)
+}
+
+// Redirect adds a BeforeEnter guard which sends every navigation of the app
+// to the path returned by the giving function, if not empty.
+func (app *NApp) Redirect(fn func(router.PushEvent) string) {
+	app.BeforeEnter(router.RedirectGuard(fn))
+}
+
+// navigate runs the guards for the navigation with the giving id, applying
+// their decision unless another navigation was started since. If not nil,
+// done is called once the navigation is settled, with true/false if its route
+// was activated.
+func (app *NApp) navigate(id int, pe router.PushDirectiveEvent, redirects int, done func(bool)) {
+	if done == nil {
+		done = func(bool) {}
+	}
+
+	to, err := app.locationEvent(pe)
+	if err != nil {
+		done(false)
+		return
+	}
+
+	app.guard(id, to, redirects, pe.Replace, done, func() bool {
+		app.location.Navigate(pe)
+		return true
+	})
+}
+
+// guard runs the guards for the navigation with the giving id to the event,
+// calling apply once they allow it unless another navigation was started
+// since, and publishing an AppUpdate if apply moved the Location. Redirects
+// navigate to their path, replacing the current entry of the history if
+// replace is set. If not nil, done is called once the navigation is settled,
+// with true/false if its route was activated.
+func (app *NApp) guard(id int, to router.PushEvent, redirects int, replace bool, done func(bool), apply func() bool) {
+	if done == nil {
+		done = func(bool) {}
+	}
+
+	from := app.location.Location()
+
+	router.RunGuards(app.guards(to), to, func(decision router.Decision) {
+		app.nml.Lock()
+		current := app.navigations == id
+		app.nml.Unlock()
+
+		if !current {
+			done(false)
+			return
+		}
+
+		switch {
+		case decision.Cancel:
+			app.notifications.Handle(NavigationCancelled{App: app, From: from, To: to, Reason: decision.Reason})
+			done(false)
+		case decision.Redirect != "" && redirects >= maxRedirects:
+			app.notifications.Handle(NavigationCancelled{App: app, From: from, To: to, Reason: "too many redirects"})
+			done(false)
+		case decision.Redirect != "":
+			app.navigate(id, router.PushDirectiveEvent{To: decision.Redirect, Replace: replace}, redirects+1, done)
+		case apply():
+			app.notifications.Handle(AppUpdate{App: app})
+			done(true)
+		default:
+			done(false)
+		}
+	})
+}
+
+// locationEvent returns the PushEvent for the path of the directive as routed
+// by the Location of the app, which defaults to routing it by its hash.
+func (app *NApp) locationEvent(pe router.PushDirectiveEvent) (router.PushEvent, error) {
+	if location, ok := app.location.(eventLocation); ok {
+		return location.event(pe)
+	}
+
+	return router.NewPushEvent(pe.To, true)
+}
+
+// guards returns the guards deciding on a navigation to the giving event,
+// which are those for leaving the active views which do not match it followed
+// by those for entering the views which match it and are not active, or are
+// active but matched with other parameters or query, where nested views are
+// left from the innermost and entered from the outermost.
+func (app *NApp) guards(to router.PushEvent) []router.Guard {
+	active := make(map[*NView]bool)
+	for _, view := range app.activeViews {
+		active[view] = true
+	}
+
+	from := app.location.Location()
+
+	var leaves, enters []router.Guard
+
+	for _, view := range app.views {
+		var current, previous []*NView
+		var previousEvents []router.PushEvent
+		if active[view] {
+			current = view.activeChain()
+			previous, previousEvents = view.routeChain(from)
+		}
+
+		next, events := view.routeChain(to)
+
+		// Views shared by both chains are not left, and are only entered
+		// again if matched with other parameters or query.
+		var same int
+		for same < len(current) && same < len(next) && current[same] == next[same] {
+			same++
+		}
+
+		var entered = same
+		for index := 0; index < same; index++ {
+			if index >= len(previous) || previous[index] != next[index] || !next[index].sameMatch(previousEvents[index], events[index]) {
+				entered = index
+				break
+			}
+		}
+
+		for index := len(current) - 1; index >= same; index-- {
+			leaves = append(leaves, current[index].router.Leave)
+		}
+
+		for index := entered; index < len(next); index++ {
+			nested, event := next[index], events[index]
+
+			enters = append(enters, func(_ router.PushEvent, done func(router.Decision)) {
+				nested.router.Enter(event, done)
+			})
+		}
+	}
+
+	var guards []router.Guard
+	guards = append(guards, app.leaves...)
+	guards = append(guards, leaves...)
+	guards = append(guards, app.enters...)
+	guards = append(guards, enters...)
+
+	return guards
 }
 
 // Location returns the current route. It stores all set routes and returns the
@@ -64,6 +404,90 @@ func (app *NApp) InitApp(location Location) {
 	app.location = location
 }
 
+// UseScheduler sets the Scheduler which picks when the views published in the
+// app are flushed as ViewUpdate notifications. Apps use ImmediateScheduler by
+// default, leaving drivers to opt into batching publishes through schedulers
+// like FrameScheduler.
+func (app *NApp) UseScheduler(scheduler Scheduler) {
+	app.sml.Lock()
+	app.scheduler = scheduler
+	app.sml.Unlock()
+}
+
+// Flush dispatches a single ViewUpdate for every view published since the last
+// flush, regardless of how many times each was published. Views are updated
+// after the views they depend on, and otherwise in the order they were added
+// into the app.
+func (app *NApp) Flush() {
+	app.sml.Lock()
+	dirty := app.dirty
+	app.dirty = make(map[*NView]bool)
+	app.scheduled = false
+
+	var ordered []*NView
+	if len(dirty) != 0 {
+		ordered = flushOrder(app.views, dirty)
+	}
+	app.sml.Unlock()
+
+	for _, view := range ordered {
+		app.notifications.Handle(ViewUpdate{
+			App:  app,
+			View: view,
+		})
+
+		view.Updated()
+	}
+}
+
+// flushOrder returns the dirty views in the order they were added into the
+// app, with every view following the views it depends on. Dependencies which
+// form a cycle are ordered by the first of them added into the app.
+func flushOrder(views []*NView, dirty map[*NView]bool) []*NView {
+	var ordered []*NView
+
+	visited := make(map[*NView]bool)
+
+	var visit func(view *NView)
+	visit = func(view *NView) {
+		if visited[view] {
+			return
+		}
+
+		visited[view] = true
+
+		for _, dependency := range view.depends {
+			visit(dependency.top())
+		}
+
+		if dirty[view] {
+			ordered = append(ordered, view)
+		}
+	}
+
+	for _, view := range views {
+		visit(view)
+	}
+
+	return ordered
+}
+
+// markDirty marks the giving view as published, scheduling a flush if none is
+// pending.
+func (app *NApp) markDirty(view *NView) {
+	app.sml.Lock()
+	app.dirty[view] = true
+
+	scheduler := app.scheduler
+	schedule := !app.scheduled
+	app.scheduled = true
+	app.sml.Unlock()
+
+	if schedule {
+		scheduler.Schedule(app.Flush)
+	}
+}
+
 // Do calls the giving function providing it with the NApp instance.
 func (app *NApp) Do(appFun func(*NApp)) *NApp {
 	if appFun != nil {
@@ -81,8 +505,8 @@ func (app *NApp) initSanitCheck() {
 		return
 	}
 
-	// Use the NoopLocation since we have not being set.
-	app.location = NewNoopLocation(app)
+	// Use the MemoryLocation since we have not being set.
+	app.location = NewMemoryLocation(app, DefaultHistorySize)
 }
 
 // Active returns true/false if the giving app is active and has already
@@ -98,6 +522,13 @@ func (app *NApp) Mounted() {
 	}
 }
 
+// Unmounted notifies all active views that they have been unmounted.
+func (app *NApp) Unmounted() {
+	for _, view := range app.activeViews {
+		view.Unmounted()
+	}
+}
+
 // ActivateRoute actives the views which are to be rendered.
 func (app *NApp) ActivateRoute(es interface{}) {
 	var pe router.PushEvent
@@ -136,16 +567,21 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 		app.ActivateRoute(es)
 	}
 
+	app.resetIDs()
+
 	var tjson AppJSON
+	tjson.AppID = app.uuid
 	tjson.Name = app.title
 
 	toHead, toBody := app.Resources()
 
 	for _, item := range toHead {
+		app.assignIDs(item, nil)
 		tjson.HeadResources = append(tjson.HeadResources, item.TreeJSON())
 	}
 
 	for _, item := range toBody {
+		app.assignIDs(item, nil)
 		tjson.BodyResources = append(tjson.BodyResources, item.TreeJSON())
 	}
 
@@ -167,11 +603,77 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 	script := trees.NewMarkup("script", false)
 	trees.NewAttr("type", "text/javascript").Apply(script)
 	trees.NewText(core.JavascriptDriverCore).Apply(script)
+	app.assignIDs(script, nil)
 	tjson.BodyResources = append(tjson.BodyResources, script.TreeJSON())
 
 	return tjson
 }
 
+// HydrateJSON returns the AppJSON of the markup produced by the last render of
+// the app, without rendering the views again, so the uids it carries match the
+// markup already in the DOM for the client to adopt. The core javascript is not
+// included, as it is already part of the rendered page.
+func (app *NApp) HydrateJSON() AppJSON {
+	var tjson AppJSON
+	tjson.AppID = app.uuid
+	tjson.Name = app.title
+
+	toHead, toBody := app.Resources()
+
+	for _, item := range toHead {
+		tjson.HeadResources = append(tjson.HeadResources, item.TreeJSON())
+	}
+
+	for _, item := range toBody {
+		tjson.BodyResources = append(tjson.BodyResources, item.TreeJSON())
+	}
+
+	var afterBody []ViewJSON
+
+	for _, view := range app.activeViews {
+		if view.live == nil {
+			continue
+		}
+
+		vjson := ViewJSON{
+			AppID:  view.appUUID,
+			ViewID: view.uuid,
+			Tree:   view.live.TreeJSON(),
+		}
+
+		switch view.target {
+		case HeadTarget:
+			tjson.Head = append(tjson.Head, vjson)
+		case BodyTarget:
+			tjson.Body = append(tjson.Body, vjson)
+		case AfterBodyTarget:
+			afterBody = append(afterBody, vjson)
+		}
+	}
+
+	tjson.Body = append(tjson.Body, afterBody...)
+
+	return tjson
+}
+
+// ActiveViews returns the views activated by the last route of the app, in the
+// order they were added into the app.
+func (app *NApp) ActiveViews() []*NView {
+	return app.activeViews
+}
+
+// HasRendered returns true/false if the app has been rendered and has markup
+// to be hydrated.
+func (app *NApp) HasRendered() bool {
+	for _, view := range app.activeViews {
+		if view.HasRendered() {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Render returns the giving rendered tree of the app respective of the path
 // found.
 func (app *NApp) Render(es interface{}) *trees.Markup {
@@ -179,6 +681,8 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 		app.ActivateRoute(es)
 	}
 
+	app.resetIDs()
+
 	var html = trees.NewMarkup("html", false)
 	var head = trees.NewMarkup("head", false)
 
@@ -196,15 +700,19 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 	head.AddChild(toHead...)
 
 	var last = elems.Div()
+	var views = make(map[*trees.Markup]bool)
 
 	for _, view := range app.activeViews {
+		markup := view.Render()
+		views[markup] = true
+
 		switch view.target {
 		case HeadTarget:
-			view.Render().Apply(head)
+			markup.Apply(head)
 		case BodyTarget:
-			view.Render().Apply(body)
+			markup.Apply(body)
 		case AfterBodyTarget:
-			view.Render().Apply(last)
+			markup.Apply(last)
 		}
 	}
 
@@ -218,9 +726,18 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 
 	body.AddChild(toBody...)
 
+	app.assignIDs(html, views)
+
 	return html
 }
 
+// RenderTo renders the app respective of the path found, streaming its html
+// into the provided writer through trees.SimpleElementWriter. The head of the
+// page is flushed once written if the writer supports it.
+func (app *NApp) RenderTo(w io.Writer, es interface{}) (int64, error) {
+	return trees.SimpleElementWriter.WriteTo(w, app.Render(es))
+}
+
 // PushViews returns a slice of  views that match and pass the provided path.
 func (app *NApp) PushViews(event router.PushEvent) []*NView {
 	// fmt.Printf("Routing Path: %s\n", event.Rem)
@@ -259,6 +776,68 @@ func (app *NApp) Resources() ([]*trees.Markup, []*trees.Markup) {
 	return app.resourceHeader, app.resourceBody
 }
 
+// Notifications returns the dispatcher owned by the app, which delivers the
+// notifications of the app and its views, like ViewUpdate and AppUpdate.
+// Drivers dispatch the common.EventBroadcast of dom events through it, which
+// are delivered to the handlers of the events of the same id rendered by the
+// active views of the app.
+func (app *NApp) Notifications() *notifications.Notifications {
+	return app.notifications
+}
+
+// handleEvent delivers the event broadcast to the handlers of the events with
+// its id in the last render of the active views of the app.
+func (app *NApp) handleEvent(broadcast common.EventBroadcast) {
+	type match struct {
+		handler func(common.EventObject, *trees.Markup)
+		tree    *trees.Markup
+	}
+
+	// handlers are collected first as they may render the views again.
+	var matched []match
+
+	for _, view := range app.activeViews {
+		if view.live == nil {
+			continue
+		}
+
+		view.live.EachEvent(func(ev *trees.Event, tree *trees.Markup) {
+			if ev.Handler != nil && !tree.Removed() && ev.ID() == broadcast.EventID {
+				matched = append(matched, match{handler: ev.Handler, tree: tree})
+			}
+		})
+	}
+
+	for _, m := range matched {
+		m.handler(broadcast.Event, m.tree)
+	}
+}
+
+// Event returns the event with the giving id in the last render of the active
+// views of the app, with the options it was registered with. It returns false
+// if no such event is rendered.
+func (app *NApp) Event(id string) (*trees.Event, bool) {
+	var found *trees.Event
+
+	for _, view := range app.activeViews {
+		if found != nil {
+			break
+		}
+
+		if view.live == nil {
+			continue
+		}
+
+		view.live.EachEvent(func(ev *trees.Event, tree *trees.Markup) {
+			if found == nil && !tree.Removed() && ev.ID() == id {
+				found = ev
+			}
+		})
+	}
+
+	return found, found != nil
+}
+
 // UUID returns the uuid specific to the giving view.
 func (app *NApp) UUID() string {
 	return app.uuid
@@ -284,6 +863,69 @@ const (
 
 // View returns a new instance of the view object.
 func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *NView {
+	vw, _ := app.view("", renderable, route, target)
+	return vw
+}
+
+// NamedView returns a new instance of the view object whose route is named,
+// allowing its url to be built through URLFor and Link. Route names are kept
+// by each app, where registering a name already used by the app for another
+// route fails with router.ErrRouteConflict.
+func (app *NApp) NamedView(name string, renderable interface{}, route string, target ViewTarget) (*NView, error) {
+	return app.view(name, renderable, route, target)
+}
+
+// URLFor returns the path of the route of the view with the giving name, with
+// the parameters of its pattern filled from params.
+func (app *NApp) URLFor(name string, params router.Params) (string, error) {
+	return app.names.URL(name, params)
+}
+
+// Link returns an anchor whose href is the url of the route with the giving
+// name and which navigates the app to it when clicked, instead of reloading
+// the page. The route must be named before the link is created, as it panics
+// if the url can not be built, as done for the routes given to ActivateRoute.
+func (app *NApp) Link(name string, params router.Params, markup ...trees.Appliable) *trees.Markup {
+	url, err := app.URLFor(name, params)
+	if err != nil {
+		panic(fmt.Sprintf("Unable to create link for route %q: %q", name, err.Error()))
+	}
+
+	link := elems.Anchor(markup...)
+	trees.NewAttr("href", url).Apply(link)
+
+	events.ClickEvent(func() {
+		app.Navigate(router.PushDirectiveEvent{To: url})
+	}, trees.PreventDefault(true)).Apply(link)
+
+	return link
+}
+
+// view returns a new instance of the view object with the giving route name.
+func (app *NApp) view(name string, renderable interface{}, route string, target ViewTarget) (*NView, error) {
+	vw, err := app.newView(name, renderable, route, target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Register to listen for failure of route to match and
+	// notify unmount call.
+	vw.router.Failed(func(push router.PushEvent) {
+		vw.disableView()
+		vw.Unmounted()
+	})
+
+	app.sml.Lock()
+	app.views = append(app.views, vw)
+	app.sml.Unlock()
+
+	return vw, nil
+}
+
+// newView returns a new view with the giving route name, nested in the
+// parent view if not nil. Named views fail with router.ErrRouteConflict if
+// the app uses the name for another route.
+func (app *NApp) newView(name string, renderable interface{}, route string, target ViewTarget, parent *NView) (*NView, error) {
 	app.initSanitCheck()
 
 	if route == "" {
@@ -308,32 +950,37 @@ func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *
 
 	var vw NView
 	vw.root = app
+	vw.parent = parent
 	vw.target = target
 	vw.base = base
-	vw.uuid = NewKey()
+	vw.outlet = elems.OutletSelector
+	vw.router = router.NewResolver(route)
+
+	// Nested views are named by their full pattern, which includes the
+	// patterns of their parents.
+	if name != "" {
+		if err := app.names.Add(name, vw.pattern()); err != nil {
+			return nil, err
+		}
+	}
+
+	vw.uuid = app.newKey()
 	vw.appUUID = app.uuid
 	vw.Reactive = NewReactive()
+	vw.mounted = NewSubscriptions()
+	vw.rendered = NewSubscriptions()
+	vw.updated = NewSubscriptions()
+	vw.unmounted = NewSubscriptions()
 
-	vw.router = router.NewResolver(route)
-
-	// app.driver.Update(app, &vw)
+	// Nested views are rendered within the top view of their chain, which is
+	// the one to update when they change.
 	vw.React(func() {
-		notifications.Dispatch(ViewUpdate{
-			App:  app,
-			View: &vw,
-		})
+		app.markDirty(vw.top())
 	})
 
-	// Register to listen for failure of route to match and
-	// notify unmount call.
-	vw.router.Failed(func(push router.PushEvent) {
-		vw.disableView()
-		vw.Unmounted()
-	})
+	vw.attach(base)
 
-	app.views = append(app.views, &vw)
-
-	return &vw
+	return &vw, nil
 }
 
 // RenderableData defines a struct which contains the name of a giving renderable
@@ -357,6 +1004,19 @@ type NView struct {
 	// location      Location
 	router router.Resolver
 
+	// parent is the view the view is nested in, with children holding the
+	// views nested in the view, of which child is the one matching the
+	// current route, rendered into the element of the view matching outlet.
+	parent    *NView
+	children  []*NView
+	child     *NView
+	outlet    string
+	isMounted bool
+
+	// live holds a copy of the last rendered markup of the view which is used
+	// to produce patches for the next render.
+	live *trees.Markup
+
 	mounted   Subscriptions
 	rendered  Subscriptions
 	updated   Subscriptions
@@ -365,6 +1025,248 @@ type NView struct {
 	beginComponents []*Component
 	anyComponents   []*Component
 	lastComponents  []*Component
+
+	// depends holds the views whose updates are flushed before those of the
+	// view.
+	depends []*NView
+}
+
+// DependsOn sets the view to be updated after the giving views when they are
+// flushed together, for views which render state changed by them. Nested
+// views are updated with the top view of their chain, which takes on their
+// dependencies.
+func (v *NView) DependsOn(views ...*NView) *NView {
+	top := v.top()
+
+	v.root.sml.Lock()
+	top.depends = append(top.depends, views...)
+	v.root.sml.Unlock()
+
+	return v
+}
+
+// BeforeEnter adds a Guard which decides on navigations into the route of
+// the view.
+func (v *NView) BeforeEnter(guard router.Guard) *NView {
+	v.router.BeforeEnter(guard)
+	return v
+}
+
+// BeforeLeave adds a Guard which decides on navigations away from the route
+// of the view, like when it holds unsaved changes.
+func (v *NView) BeforeLeave(guard router.Guard) *NView {
+	v.router.BeforeLeave(guard)
+	return v
+}
+
+// Redirect adds a Guard which sends navigations into the route of the view to
+// the path returned by the giving function, if not empty.
+func (v *NView) Redirect(fn func(router.PushEvent) string) *NView {
+	v.router.Redirect(fn)
+	return v
+}
+
+// View returns a new view nested in the view, rendered into its outlet when
+// its route matches what is left of the path after the route of the view.
+// The route of the view must end with a '/*' to leave a path for the nested
+// views, of which only the first one matching it is rendered, making a route
+// of '*' a fallback when added last. Parameters matched by the view are
+// passed down to the nested views. It panics if the route of the view does
+// not end with '/*'.
+func (v *NView) View(renderable interface{}, route string) *NView {
+	child, _ := v.view("", renderable, route)
+	return child
+}
+
+// NamedView returns a new nested view like View whose route is named, with
+// the full pattern including the routes of the views it is nested in,
+// allowing its url to be built through URLFor and Link. It fails with
+// router.ErrRouteConflict if the app uses the name for another route.
+func (v *NView) NamedView(name string, renderable interface{}, route string) (*NView, error) {
+	return v.view(name, renderable, route)
+}
+
+// Outlet sets the css selector of the element into which the nested views are
+// rendered, which defaults to elems.OutletSelector. The nested views are added
+// at the end of the view if no element matches it.
+func (v *NView) Outlet(selector string) *NView {
+	v.outlet = selector
+	return v
+}
+
+// view returns a new view nested in the view with the giving route name.
+func (v *NView) view(name string, renderable interface{}, route string) (*NView, error) {
+	if path, _ := splitPattern(v.router.Pattern()); !strings.HasSuffix(path, "*") {
+		panic(fmt.Sprintf("Unable to nest view in route %q: route must end with '/*'", v.router.Pattern()))
+	}
+
+	child, err := v.root.newView(name, renderable, route, v.target, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(v.children) == 0 {
+		v.router.Done(v.activateChild)
+	}
+
+	v.children = append(v.children, child)
+
+	return child, nil
+}
+
+// activateChild routes the event left after the route of the view to the first
+// nested view matching it, unmounting the nested view it replaces and mounting
+// the new one if the view is mounted. Nested views which remain matched are
+// left mounted.
+func (v *NView) activateChild(pe router.PushEvent) {
+	var next *NView
+
+	for _, child := range v.children {
+		if _, _, ok := child.router.Test(pe.Rem); ok {
+			next = child
+			break
+		}
+	}
+
+	prev := v.child
+	v.child = next
+
+	if next != nil {
+		next.propagateRoute(pe)
+	}
+
+	if next == prev {
+		return
+	}
+
+	if prev != nil && prev.isMounted {
+		prev.Unmounted()
+	}
+
+	if next != nil && v.isMounted {
+		next.Mounted()
+	}
+}
+
+// top returns the view at the top of the chain of nested views of the view.
+func (v *NView) top() *NView {
+	for v.parent != nil {
+		v = v.parent
+	}
+
+	return v
+}
+
+// pattern returns the full pattern of the route of the view, joined with the
+// patterns of the views it is nested in.
+func (v *NView) pattern() string {
+	if v.parent == nil {
+		return v.router.Pattern()
+	}
+
+	parentPath, parentQuery := splitPattern(v.parent.pattern())
+	path, query := splitPattern(v.router.Pattern())
+
+	full := strings.TrimSuffix(strings.TrimSuffix(parentPath, "*"), "/")
+	if path != "*" {
+		full += "/" + strings.TrimPrefix(path, "/")
+	} else {
+		full += "/*"
+	}
+
+	var queries []string
+	for _, q := range []string{parentQuery, query} {
+		if q != "" {
+			queries = append(queries, q)
+		}
+	}
+
+	if len(queries) != 0 {
+		full += "?" + strings.Join(queries, "&")
+	}
+
+	return full
+}
+
+// splitPattern returns the path of the pattern and the query it declares.
+func splitPattern(pattern string) (string, string) {
+	if index := strings.Index(pattern, "?"); index != -1 {
+		return pattern[:index], pattern[index+1:]
+	}
+
+	return pattern, ""
+}
+
+// activeChain returns the view and the nested views matching the current
+// route below it.
+func (v *NView) activeChain() []*NView {
+	var chain []*NView
+
+	for view := v; view != nil; view = view.child {
+		chain = append(chain, view)
+	}
+
+	return chain
+}
+
+// routeChain returns the view and the nested views below it which match the
+// event, along with the event each of them is routed with.
+func (v *NView) routeChain(pe router.PushEvent) ([]*NView, []router.PushEvent) {
+	params, rem, ok := v.router.Test(pe.Rem)
+	if !ok {
+		return nil, nil
+	}
+
+	next := pe
+	next.Rem = rem
+	next.Params = make(map[string]string)
+
+	for key, value := range pe.Params {
+		next.Params[key] = value
+	}
+
+	for key, value := range params {
+		next.Params[key] = value
+	}
+
+	views, events := []*NView{v}, []router.PushEvent{pe}
+
+	for _, child := range v.children {
+		if nested, nestedEvents := child.routeChain(next); len(nested) != 0 {
+			return append(views, nested...), append(events, nestedEvents...)
+		}
+	}
+
+	return views, events
+}
+
+// sameMatch returns true/false if the view matches both events with the same
+// parameters and query.
+func (v *NView) sameMatch(a, b router.PushEvent) bool {
+	if a.Query.Encode() != b.Query.Encode() {
+		return false
+	}
+
+	aParams, _, _ := v.router.Test(a.Rem)
+	bParams, _, _ := v.router.Test(b.Rem)
+
+	return sameParams(a.Params, b.Params) && sameParams(aParams, bParams)
+}
+
+// sameParams returns true/false if both sets of parameters hold the same
+// values.
+func sameParams(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+
+	return true
 }
 
 // UUID returns the uuid specific to the giving view.
@@ -395,6 +1297,45 @@ func (v *NView) RenderJSON() ViewJSON {
 	}
 }
 
+// ViewPatchJSON defines a struct which holds the patches which turn the last
+// rendered markup of a view into its current markup.
+type ViewPatchJSON struct {
+	AppID   string            `json:"AppID"`
+	ViewID  string            `json:"ViewID"`
+	TreeID  string            `json:"TreeID"`
+	Events  []trees.EventJSON `json:"Events"`
+	Patches []trees.Patch     `json:"Patches"`
+}
+
+// RenderPatch returns the ViewPatchJSON for the provided View which contains the
+// patches between the last rendered markup of the view and its current markup,
+// along with the current events of the view.
+func (v *NView) RenderPatch() ViewPatchJSON {
+	last := v.live
+
+	tree := v.render()
+	patches := trees.Diff(last, tree)
+	v.live = tree.Clone()
+
+	var patch ViewPatchJSON
+	patch.AppID = v.appUUID
+	patch.ViewID = v.uuid
+	patch.TreeID = tree.UID()
+	patch.Patches = patches
+
+	tree.EachEvent(func(event *trees.Event, _ *trees.Markup) {
+		patch.Events = append(patch.Events, event.EventJSON())
+	})
+
+	return patch
+}
+
+// HasRendered returns true/false if the giving view has already been rendered
+// and has a markup to patch against.
+func (v *NView) HasRendered() bool {
+	return v.live != nil
+}
+
 // Target returns the associated view target.
 func (v *NView) Target() ViewTarget {
 	return v.target
@@ -402,8 +1343,38 @@ func (v *NView) Target() ViewTarget {
 
 // Render returns the markup for the giving views.
 func (v *NView) Render() *trees.Markup {
+	base := v.render()
+	v.live = base.Clone()
+
+	return base
+}
+
+// render returns the markup for the giving views without keeping a copy.
+func (v *NView) render() *trees.Markup {
+	base := v.build()
+
+	if v.root.ids != nil {
+		trees.AssignIDs(base, v.root.ids)
+	} else {
+		base.UpdateHash()
+	}
+
+	base.SwapUID(v.uuid)
+
+	return base
+}
+
+// build returns the markup of the view with its components and the nested
+// view matching the current route, without assigning its ids.
+func (v *NView) build() *trees.Markup {
 	base := v.base.Render()
 
+	// The markup is changed by the components and the nested view, so a copy
+	// is used to keep the markup of static renderables as given.
+	if v.child != nil || len(v.beginComponents)+len(v.anyComponents)+len(v.lastComponents) != 0 {
+		base = base.Clone()
+	}
+
 	// Process the begin components and immediately add appropriately into base.
 	for _, component := range v.beginComponents {
 		if component.Target == "" {
@@ -449,8 +1420,16 @@ func (v *NView) Render() *trees.Markup {
 		}
 	}
 
-	base.SwapUID(v.uuid)
-	base.UpdateHash()
+	// Process the nested view into the outlet, else at the end of base.
+	if v.child != nil {
+		render := v.child.build()
+
+		if outlet := trees.Query.Query(base, v.outlet); outlet != nil {
+			outlet.AddChild(render)
+		} else {
+			base.AddChild(render)
+		}
+	}
 
 	return base
 }
@@ -460,14 +1439,25 @@ func (v *NView) propagateRoute(pe router.PushEvent) {
 	v.router.Resolve(pe)
 }
 
-// Unmounted publishes changes notifications that the view is unmounted.
+// Unmounted publishes changes notifications that the view is unmounted, along
+// with the nested view matching the current route.
 func (v *NView) Unmounted() {
+	v.isMounted = false
 	v.unmounted.Publish()
+
+	if v.child != nil && v.child.isMounted {
+		v.child.Unmounted()
+	}
 }
 
-// Updated publishes changes notifications that the view is updated.
+// Updated publishes changes notifications that the view is updated, along
+// with the nested view matching the current route.
 func (v *NView) Updated() {
 	v.updated.Publish()
+
+	if v.child != nil {
+		v.child.Updated()
+	}
 }
 
 // Rendered publishes changes notifications that the view is rendered.
@@ -475,9 +1465,35 @@ func (v *NView) Rendered() {
 	v.rendered.Publish()
 }
 
-// Mounted publishes changes notifications that the view is mounted.
+// Mounted publishes changes notifications that the view is mounted, along
+// with the nested view matching the current route.
 func (v *NView) Mounted() {
+	v.isMounted = true
 	v.mounted.Publish()
+
+	if v.child != nil && !v.child.isMounted {
+		v.child.Mounted()
+	}
+}
+
+// attach registers the giving renderable with the lifecycle of the view based
+// on the interfaces it implements.
+func (v *NView) attach(renderable Renderable) {
+	if registerer, ok := renderable.(ServicesRegisterer); ok {
+		registerer.RegisterServices(v.Services())
+	}
+
+	if mounter, ok := renderable.(Mounter); ok {
+		v.mounted.React(mounter.Mount)
+	}
+
+	if unmounter, ok := renderable.(Unmounter); ok {
+		v.unmounted.React(unmounter.Unmount)
+	}
+
+	if updater, ok := renderable.(Updater); ok {
+		v.updated.React(updater.Update)
+	}
 }
 
 // RenderingOrder defines a type used to define the order which rendering is to be done for a resource.
@@ -506,10 +1522,14 @@ func (v *NView) Services() Services {
 		Unmounted: v.unmounted,
 		Updated:   v.updated,
 		Rendered:  v.rendered,
+
+		Notifications: v.root.notifications,
 	}
 }
 
-// Component adds the provided component into the selected view.
+// Component adds the provided component into the selected view. When target
+// is not empty, it's a css selector matched through trees.Query against the
+// markup of the view, with the component added to every element matching it.
 func (v *NView) Component(renderable interface{}, order RenderingOrder, route string, target string) {
 	var base Renderable
 
@@ -528,7 +1548,7 @@ func (v *NView) Component(renderable interface{}, order RenderingOrder, route st
 	}
 
 	var c Component
-	c.uuid = NewKey()
+	c.uuid = v.root.newKey()
 	c.Target = target
 	c.Rendering = base
 	c.Reactive = NewReactive()
@@ -542,6 +1562,8 @@ func (v *NView) Component(renderable interface{}, order RenderingOrder, route st
 	// Connect the view to react to a change from the component.
 	c.React(v.Publish)
 
+	v.attach(base)
+
 	// Register the component router into the views router.
 	v.router.Register(c.Router)
 
@@ -576,8 +1598,16 @@ func (c Component) UUID() string {
 	return c.uuid
 }
 
-// Render returns the markup corresponding to the internal Renderable.
+// Render returns the markup corresponding to the internal Renderable. If the
+// Renderable implements ShouldUpdater and reports no need for an update, a
+// copy of the markup of the last render is returned, as the last render is
+// still attached to the markup of the previous render of its view. Other
+// Renderables must return a new markup on every render.
 func (c *Component) Render() *trees.Markup {
+	if updater, ok := c.Rendering.(ShouldUpdater); ok && c.live != nil && !updater.ShouldUpdate() {
+		return c.live.Clone()
+	}
+
 	newTree := c.Rendering.Render()
 	newTree.SwapUID(c.uuid)
 
@@ -653,7 +1683,9 @@ func (a *ApplyView) Render() *trees.Markup {
 
 	a.base.Empty()
 
-	root := children[0]
+	// A copy is returned as the content may apply the same markup on every
+	// render.
+	root := children[0].Clone()
 	if a.Morph {
 		return root.ApplyMorphers()
 	}
@@ -692,13 +1724,16 @@ func (s *StaticView) UUID() string {
 	return s.uid
 }
 
-// Render returns the markup for the static view.
+// Render returns a copy of the markup for the static view, so changes to
+// Content are rendered and the markup given to a view is never re-parented.
 func (s *StaticView) Render() *trees.Markup {
+	content := s.Content.Clone()
+
 	if s.Morph {
-		return s.Content.ApplyMorphers()
+		return content.ApplyMorphers()
 	}
 
-	return s.Content
+	return content
 }
 
 // RenderHTML returns the html template version of the StaticView content.
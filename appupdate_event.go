@@ -1,6 +1,10 @@
 package gu
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/gu-io/gu/common"
+)
 
 // AppUpdateSubscriber defines a interface that which is used to subscribe specifically for
 // events  AppUpdate type.
@@ -42,17 +46,15 @@ func (sn *AppUpdateHandler) Handle(receive interface{}) {
 // receive AppUpdate type has a event.
 type AppUpdateNotification struct {
 	sml        sync.Mutex
-	subs       []AppUpdateSubscriber
+	subs       []*AppUpdateSubscription
 	validation func(AppUpdate) bool
-	register   map[AppUpdateSubscriber]int
 }
 
 // NewAppUpdateNotificationWith returns a new instance of AppUpdateNotification.
+// Events which fail the validation function are not delivered to any subscriber.
 func NewAppUpdateNotificationWith(validation func(AppUpdate) bool) *AppUpdateNotification {
 	var elem AppUpdateNotification
-
 	elem.validation = validation
-	elem.register = make(map[AppUpdateSubscriber]int, 0)
 
 	return &elem
 }
@@ -60,52 +62,144 @@ func NewAppUpdateNotificationWith(validation func(AppUpdate) bool) *AppUpdateNot
 // NewAppUpdateNotification returns a new instance of NewAppUpdateNotification.
 func NewAppUpdateNotification() *AppUpdateNotification {
 	var elem AppUpdateNotification
-	elem.register = make(map[AppUpdateSubscriber]int, 0)
-
 	return &elem
 }
 
 // UnNotify removes the given subscriber from the notification's list if found from future events.
 func (sn *AppUpdateNotification) UnNotify(sub AppUpdateSubscriber) {
 	sn.do(func() {
-		index, ok := sn.register[sub]
-		if !ok {
-			return
+		var subs []*AppUpdateSubscription
+
+		for _, subscription := range sn.subs {
+			if subscription.sub == sub {
+				subscription.done = true
+				continue
+			}
+
+			subs = append(subs, subscription)
 		}
 
-		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+		sn.subs = subs
 	})
 }
 
 // Notify adds the given subscriber into the notification list and will await an update of
-// a new event of the given AppUpdate type.
-func (sn *AppUpdateNotification) Notify(sub AppUpdateSubscriber) {
-	sn.do(func() {
-		sn.register[sub] = len(sn.subs)
-		sn.subs = append(sn.subs, sub)
-	})
+// a new event of the given AppUpdate type. The returned common.Remover removes the subscription.
+func (sn *AppUpdateNotification) Notify(sub AppUpdateSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, false, 0)
+}
+
+// NotifyPriority adds the given subscriber into the notification list with the giving
+// priority. Subscribers with a higher priority receive events first, while those with
+// the same priority receive them in the order they subscribed. Notify uses a priority of 0.
+func (sn *AppUpdateNotification) NotifyPriority(priority int, sub AppUpdateSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, false, priority)
+}
+
+// NotifyOnce adds the given subscriber into the notification list for only the next
+// event of the given AppUpdate type, after which it is removed.
+func (sn *AppUpdateNotification) NotifyOnce(sub AppUpdateSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, true, 0)
+}
+
+// NotifyWhen adds the given subscriber into the notification list to receive only the
+// events of the given AppUpdate type which match the predicate.
+func (sn *AppUpdateNotification) NotifyWhen(predicate func(AppUpdate) bool, sub AppUpdateSubscriber) common.Remover {
+	return sn.subscribe(sub, predicate, false, 0)
 }
 
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
+// Events which fail the validation of the notification are ignored.
 func (sn *AppUpdateNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(AppUpdate); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	elemEvent, ok := elem.(AppUpdate)
+	if !ok {
+		return
+	}
+
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return
+	}
+
+	// The list is replaced on every change, which allows subscribers to be
+	// added and removed while the event is delivered.
+	var subs []*AppUpdateSubscription
+	sn.do(func() {
+		subs = sn.subs
+	})
+
+	for _, subscription := range subs {
+		if subscription.predicate != nil && !subscription.predicate(elemEvent) {
+			continue
+		}
+
+		if !sn.claim(subscription) {
+			continue
+		}
+
+		subscription.sub.Receive(elemEvent)
+	}
+}
+
+// subscribe adds a new subscription of the giving subscriber into the notification list,
+// after the subscriptions with the same or a higher priority.
+func (sn *AppUpdateNotification) subscribe(sub AppUpdateSubscriber, predicate func(AppUpdate) bool, once bool, priority int) *AppUpdateSubscription {
+	subscription := &AppUpdateSubscription{
+		sn:        sn,
+		sub:       sub,
+		once:      once,
+		priority:  priority,
+		predicate: predicate,
+	}
 
+	sn.do(func() {
+		index := len(sn.subs)
+		for index > 0 && sn.subs[index-1].priority < priority {
+			index--
+		}
+
+		subs := make([]*AppUpdateSubscription, 0, len(sn.subs)+1)
+		subs = append(subs, sn.subs[:index]...)
+		subs = append(subs, subscription)
+		sn.subs = append(subs, sn.subs[index:]...)
+	})
+
+	return subscription
+}
+
+// claim returns true/false if the giving subscription is still to receive the
+// event, removing it when it is a once-only subscription.
+func (sn *AppUpdateNotification) claim(subscription *AppUpdateSubscription) bool {
+	var claimed bool
+
+	sn.do(func() {
+		if subscription.done {
 			return
 		}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+		claimed = true
+
+		if subscription.once {
+			sn.remove(subscription)
+		}
+	})
+
+	return claimed
+}
+
+// remove removes the giving subscription from the notification list, it must be
+// called with the mutex locked.
+func (sn *AppUpdateNotification) remove(subscription *AppUpdateSubscription) {
+	subscription.done = true
+
+	var subs []*AppUpdateSubscription
+	for _, item := range sn.subs {
+		if item != subscription {
+			subs = append(subs, item)
+		}
 	}
+
+	sn.subs = subs
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
@@ -120,3 +214,39 @@ func (sn *AppUpdateNotification) do(fn func()) {
 
 	fn()
 }
+
+//=========================================================================================================
+
+// AppUpdateSubscription defines a single subscription of a AppUpdateSubscriber into a
+// AppUpdateNotification, which implements the common.Remover interface.
+type AppUpdateSubscription struct {
+	sn        *AppUpdateNotification
+	sub       AppUpdateSubscriber
+	once      bool
+	done      bool
+	priority  int
+	predicate func(AppUpdate) bool
+	removals  []func()
+}
+
+// Add adds the giving function to be called when the subscription is removed.
+func (s *AppUpdateSubscription) Add(fn func()) {
+	s.sn.do(func() {
+		s.removals = append(s.removals, fn)
+	})
+}
+
+// Remove removes the subscription from its notification list, calling the
+// functions added to it.
+func (s *AppUpdateSubscription) Remove() {
+	var removals []func()
+
+	s.sn.do(func() {
+		s.sn.remove(s)
+		removals, s.removals = s.removals, nil
+	})
+
+	for _, fn := range removals {
+		fn()
+	}
+}
@@ -42,17 +42,15 @@ func (sn *EventBroadcastHandler) Handle(receive interface{}) {
 // receive EventBroadcast type has a event.
 type EventBroadcastNotification struct {
 	sml        sync.Mutex
-	subs       []EventBroadcastSubscriber
+	subs       []*EventBroadcastSubscription
 	validation func(EventBroadcast) bool
-	register   map[EventBroadcastSubscriber]int
 }
 
 // NewEventBroadcastNotificationWith returns a new instance of EventBroadcastNotification.
+// Events which fail the validation function are not delivered to any subscriber.
 func NewEventBroadcastNotificationWith(validation func(EventBroadcast) bool) *EventBroadcastNotification {
 	var elem EventBroadcastNotification
-
 	elem.validation = validation
-	elem.register = make(map[EventBroadcastSubscriber]int, 0)
 
 	return &elem
 }
@@ -60,52 +58,144 @@ func NewEventBroadcastNotificationWith(validation func(EventBroadcast) bool) *Ev
 // NewEventBroadcastNotification returns a new instance of NewEventBroadcastNotification.
 func NewEventBroadcastNotification() *EventBroadcastNotification {
 	var elem EventBroadcastNotification
-	elem.register = make(map[EventBroadcastSubscriber]int, 0)
-
 	return &elem
 }
 
 // UnNotify removes the given subscriber from the notification's list if found from future events.
 func (sn *EventBroadcastNotification) UnNotify(sub EventBroadcastSubscriber) {
 	sn.do(func() {
-		index, ok := sn.register[sub]
-		if !ok {
-			return
+		var subs []*EventBroadcastSubscription
+
+		for _, subscription := range sn.subs {
+			if subscription.sub == sub {
+				subscription.done = true
+				continue
+			}
+
+			subs = append(subs, subscription)
 		}
 
-		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+		sn.subs = subs
 	})
 }
 
 // Notify adds the given subscriber into the notification list and will await an update of
-// a new event of the given EventBroadcast type.
-func (sn *EventBroadcastNotification) Notify(sub EventBroadcastSubscriber) {
-	sn.do(func() {
-		sn.register[sub] = len(sn.subs)
-		sn.subs = append(sn.subs, sub)
-	})
+// a new event of the given EventBroadcast type. The returned Remover removes the subscription.
+func (sn *EventBroadcastNotification) Notify(sub EventBroadcastSubscriber) Remover {
+	return sn.subscribe(sub, nil, false, 0)
+}
+
+// NotifyPriority adds the given subscriber into the notification list with the giving
+// priority. Subscribers with a higher priority receive events first, while those with
+// the same priority receive them in the order they subscribed. Notify uses a priority of 0.
+func (sn *EventBroadcastNotification) NotifyPriority(priority int, sub EventBroadcastSubscriber) Remover {
+	return sn.subscribe(sub, nil, false, priority)
+}
+
+// NotifyOnce adds the given subscriber into the notification list for only the next
+// event of the given EventBroadcast type, after which it is removed.
+func (sn *EventBroadcastNotification) NotifyOnce(sub EventBroadcastSubscriber) Remover {
+	return sn.subscribe(sub, nil, true, 0)
+}
+
+// NotifyWhen adds the given subscriber into the notification list to receive only the
+// events of the given EventBroadcast type which match the predicate.
+func (sn *EventBroadcastNotification) NotifyWhen(predicate func(EventBroadcast) bool, sub EventBroadcastSubscriber) Remover {
+	return sn.subscribe(sub, predicate, false, 0)
 }
 
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
+// Events which fail the validation of the notification are ignored.
 func (sn *EventBroadcastNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(EventBroadcast); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	elemEvent, ok := elem.(EventBroadcast)
+	if !ok {
+		return
+	}
 
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return
+	}
+
+	// The list is replaced on every change, which allows subscribers to be
+	// added and removed while the event is delivered.
+	var subs []*EventBroadcastSubscription
+	sn.do(func() {
+		subs = sn.subs
+	})
+
+	for _, subscription := range subs {
+		if subscription.predicate != nil && !subscription.predicate(elemEvent) {
+			continue
+		}
+
+		if !sn.claim(subscription) {
+			continue
+		}
+
+		subscription.sub.Receive(elemEvent)
+	}
+}
+
+// subscribe adds a new subscription of the giving subscriber into the notification list,
+// after the subscriptions with the same or a higher priority.
+func (sn *EventBroadcastNotification) subscribe(sub EventBroadcastSubscriber, predicate func(EventBroadcast) bool, once bool, priority int) *EventBroadcastSubscription {
+	subscription := &EventBroadcastSubscription{
+		sn:        sn,
+		sub:       sub,
+		once:      once,
+		priority:  priority,
+		predicate: predicate,
+	}
+
+	sn.do(func() {
+		index := len(sn.subs)
+		for index > 0 && sn.subs[index-1].priority < priority {
+			index--
+		}
+
+		subs := make([]*EventBroadcastSubscription, 0, len(sn.subs)+1)
+		subs = append(subs, sn.subs[:index]...)
+		subs = append(subs, subscription)
+		sn.subs = append(subs, sn.subs[index:]...)
+	})
+
+	return subscription
+}
+
+// claim returns true/false if the giving subscription is still to receive the
+// event, removing it when it is a once-only subscription.
+func (sn *EventBroadcastNotification) claim(subscription *EventBroadcastSubscription) bool {
+	var claimed bool
+
+	sn.do(func() {
+		if subscription.done {
 			return
 		}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+		claimed = true
+
+		if subscription.once {
+			sn.remove(subscription)
+		}
+	})
+
+	return claimed
+}
+
+// remove removes the giving subscription from the notification list, it must be
+// called with the mutex locked.
+func (sn *EventBroadcastNotification) remove(subscription *EventBroadcastSubscription) {
+	subscription.done = true
+
+	var subs []*EventBroadcastSubscription
+	for _, item := range sn.subs {
+		if item != subscription {
+			subs = append(subs, item)
+		}
 	}
+
+	sn.subs = subs
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
@@ -120,3 +210,39 @@ func (sn *EventBroadcastNotification) do(fn func()) {
 
 	fn()
 }
+
+//=========================================================================================================
+
+// EventBroadcastSubscription defines a single subscription of a EventBroadcastSubscriber into a
+// EventBroadcastNotification, which implements the Remover interface.
+type EventBroadcastSubscription struct {
+	sn        *EventBroadcastNotification
+	sub       EventBroadcastSubscriber
+	once      bool
+	done      bool
+	priority  int
+	predicate func(EventBroadcast) bool
+	removals  []func()
+}
+
+// Add adds the giving function to be called when the subscription is removed.
+func (s *EventBroadcastSubscription) Add(fn func()) {
+	s.sn.do(func() {
+		s.removals = append(s.removals, fn)
+	})
+}
+
+// Remove removes the subscription from its notification list, calling the
+// functions added to it.
+func (s *EventBroadcastSubscription) Remove() {
+	var removals []func()
+
+	s.sn.do(func() {
+		s.sn.remove(s)
+		removals, s.removals = s.removals, nil
+	})
+
+	for _, fn := range removals {
+		fn()
+	}
+}
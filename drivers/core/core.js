@@ -26,6 +26,10 @@ function GuClient(onMessages, SendChannel) {
     // GuJS.MakeEventCallback defines a function to generate a callback for an event
     // meta provided
     GuJS.MakeEventCallback = function(target, eventMeta) {
+        var fired = false
+        var lastFired = 0
+        var debounced = null
+
         return function(eventObj) {
 
             // Do we match the event and possible targets for the event
@@ -35,7 +39,11 @@ function GuClient(onMessages, SendChannel) {
                     return
                 }
 
-                if (eventMeta.PreventDefault) {
+                if (eventMeta.Keys && eventMeta.Keys.indexOf(eventObj.key) === -1) {
+                    return
+                }
+
+                if (eventMeta.PreventDefault && !eventMeta.Passive) {
                     eventObj.preventDefault()
                 }
 
@@ -47,12 +55,124 @@ function GuClient(onMessages, SendChannel) {
                     eventObj.stopPropagation()
                 }
 
-                GuJS.Dispatch(GuJS.GetEvent(eventObj), eventMeta)
+                if (fired) {
+                    return
+                }
+
+                if (eventMeta.Throttle) {
+                    var now = Date.now()
+                    if (now - lastFired < eventMeta.Throttle) {
+                        return
+                    }
+
+                    lastFired = now
+                }
+
+                // the event is copied now as its values are not kept once
+                // its listeners return.
+                var event = GuJS.GetEvent(eventObj)
+
+                var dispatch = function() {
+                    if (fired) {
+                        return
+                    }
+
+                    fired = !!eventMeta.Once
+                    GuJS.Dispatch(eventMeta.EventName, event, eventMeta)
+                }
+
+                if (eventMeta.Debounce) {
+                    clearTimeout(debounced)
+                    debounced = setTimeout(dispatch, eventMeta.Debounce)
+                    return
+                }
+
+                dispatch()
             })
         }
     };
 
 
+    // GuJS.registerEvents adds listeners on the target for the giving events,
+    // collecting them into the provided list.
+    GuJS.registerEvents = function(target, events, list) {
+        GuJS.each(events || [], function(event) {
+            var newEvent = {}
+            newEvent.Event = event
+            newEvent.Callback = GuJS.MakeEventCallback(target, event)
+
+            target.addEventListener(event.Event, newEvent.Callback, {
+                capture: !!event.UseCapture,
+                passive: !!event.Passive,
+            });
+            list.push(newEvent)
+        })
+    };
+
+    // GuJS.hydrateTree compares the node of the target with the giving uid against
+    // the expected markup, replacing it with the markup if they differ and adding
+    // the differences into mismatches.
+    GuJS.hydrateTree = function(target, uid, markup, mismatches) {
+        var fragment = GuJS.createDOMFragment(markup)
+        var expected = fragment.firstElementChild
+        var actual = target.querySelector("[uid='" + uid + "']")
+
+        var found = []
+        GuJS.compareNodes(expected, actual, found)
+
+        if (!found.length) {
+            return
+        }
+
+        GuJS.each(found, function(mismatch) {
+            mismatches.push(mismatch)
+        })
+
+        if (actual) {
+            actual.parentNode.replaceChild(fragment, actual)
+            return
+        }
+
+        target.appendChild(fragment)
+    };
+
+    // GuJS.compareNodes walks the expected element against the actual one,
+    // adding the differences in tag names, uids and children into mismatches.
+    GuJS.compareNodes = function(expected, actual, mismatches) {
+        if (!expected) {
+            return
+        }
+
+        var selector = expected.nodeName.toLowerCase() + "[uid='" + expected.getAttribute("uid") + "']"
+
+        if (!actual) {
+            mismatches.push({ Selector: selector, Expected: expected.nodeName, Found: "" })
+            return
+        }
+
+        if (expected.nodeName !== actual.nodeName) {
+            mismatches.push({ Selector: selector, Expected: expected.nodeName, Found: actual.nodeName })
+            return
+        }
+
+        if (expected.getAttribute("uid") !== actual.getAttribute("uid")) {
+            mismatches.push({ Selector: selector, Expected: expected.getAttribute("uid"), Found: actual.getAttribute("uid") || "" })
+            return
+        }
+
+        var expectedChildren = expected.children
+        var actualChildren = actual.children
+
+        if (expectedChildren.length !== actualChildren.length) {
+            mismatches.push({ Selector: selector, Expected: String(expectedChildren.length), Found: String(actualChildren.length) })
+            return
+        }
+
+        for (var i = 0; i < expectedChildren.length; i++) {
+            GuJS.compareNodes(expectedChildren[i], actualChildren[i], mismatches)
+        }
+    };
+
     // GuJS.ExecuteCommand executes the provided command received.
     GuJS.ExecuteCommand = function(co) {
         if (co == null || co === undefined) {
@@ -65,6 +185,7 @@ function GuClient(onMessages, SendChannel) {
         switch (co.constructor) {
             case String:
                 command = JSON.parse(co)
+                break
             case Object:
                 command = co
         }
@@ -80,11 +201,11 @@ function GuClient(onMessages, SendChannel) {
                 // in swapping out the current content with the new content received.
 
                 var app = command.App
-                GuJS.currentAppID = app.AppID
+                GuJS.currentAppID = app.AppId
 
                 // Retrieve events map related to the giving app.
-                var appEvents = GuJS.eventsCore[app.AppID] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
-                GuJS.eventsCore[app.AppID] = appEvents
+                var appEvents = GuJS.eventsCore[app.AppId] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
+                GuJS.eventsCore[app.AppId] = appEvents
 
                 var nonGuHead = head.querySelectorAll("*:not([data-gen='gu'])")
                 var nonGuBody = head.querySelectorAll("*:not([data-gen='gu'])")
@@ -147,7 +268,7 @@ function GuClient(onMessages, SendChannel) {
                         newEvent.Callback = GuJS.MakeEventCallback(head, event)
 
                         head.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
-                        viewEvents.puhs(newEvent)
+                        viewEvents.push(newEvent)
                     })
 
                     headHTML.push(fragment)
@@ -223,6 +344,79 @@ function GuClient(onMessages, SendChannel) {
 
                 return
 
+            case "HydrateApp":
+                // Hydrating the app adopts the markup rendered by the server which is
+                // already in the DOM, only registering the events of the app against it.
+                // Markup which differs from the one expected is replaced and reported.
+
+                var app = command.App
+                GuJS.currentAppID = app.AppId
+
+                // Retrieve events map related to the giving app.
+                var appEvents = GuJS.eventsCore[app.AppId] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
+                GuJS.eventsCore[app.AppId] = appEvents
+
+                // Deregister all head base events.
+                GuJS.each(appEvents.base.headEvents, function(cb) {
+                    head.removeEventListener(cb.Event.Event, cb.Callback)
+                })
+
+                // Deregister all body base events.
+                GuJS.each(appEvents.base.bodyEvents, function(cb) {
+                    body.removeEventListener(cb.Event.Event, cb.Callback)
+                })
+
+                // Deregister all view events.
+                GuJS.each(appEvents.views, function(view) {
+                    GuJS.each(view, function(cb) {
+                        body.removeEventListener(cb.Event.Event, cb.Callback)
+                    })
+                })
+
+                appEvents.base.headEvents = [];
+                appEvents.base.bodyEvents = [];
+                appEvents.views = {};
+
+                var mismatches = []
+
+                if (body.getAttribute("gu-app-id") !== app.AppId) {
+                    mismatches.push({
+                        Selector: "body",
+                        Expected: app.AppId,
+                        Found: body.getAttribute("gu-app-id") || "",
+                    })
+                }
+
+                GuJS.each(app.HeadResources || [], function(item) {
+                    GuJS.hydrateTree(head, item.TreeID, item.Markup, mismatches)
+                    GuJS.registerEvents(head, item.Events, appEvents.base.headEvents)
+                })
+
+                GuJS.each(app.Head || [], function(item) {
+                    var viewEvents = appEvents.views[item.ViewID] = []
+
+                    GuJS.hydrateTree(head, item.ViewID, item.Tree.Markup, mismatches)
+                    GuJS.registerEvents(head, item.Tree.Events, viewEvents)
+                })
+
+                GuJS.each(app.Body || [], function(item) {
+                    var viewEvents = appEvents.views[item.ViewID] = []
+
+                    GuJS.hydrateTree(body, item.ViewID, item.Tree.Markup, mismatches)
+                    GuJS.registerEvents(body, item.Tree.Events, viewEvents)
+                })
+
+                GuJS.each(app.BodyResources || [], function(item) {
+                    GuJS.hydrateTree(body, item.TreeID, item.Markup, mismatches)
+                    GuJS.registerEvents(body, item.Events, appEvents.base.bodyEvents)
+                })
+
+                if (mismatches.length) {
+                    GuJS.Dispatch("HydrateMismatch", { AppID: app.AppId, Mismatches: mismatches }, { EventName: "HydrateMismatch" })
+                }
+
+                return
+
             case "RenderView":
                 // Rendering the app response is to clear what is currently in the view.
                 // We want specific replicate the way the gopherjs driver updates apps views
@@ -266,6 +460,46 @@ function GuClient(onMessages, SendChannel) {
 
                 return
 
+            case "PatchView":
+                // Patching the view applies the changes produced from the last
+                // render of the view directly on the live DOM, instead of
+                // rebuilding the view from its full markup.
+
+                var patch = command.Patch
+
+                // If the view is from a different app then don't service.
+                if (GuJS.currentAppID && patch.AppID !== GuJS.currentAppID) {
+                    return
+                }
+
+                // Retrieve events map related to the giving app.
+                var appEvents = GuJS.eventsCore[patch.AppID] || { views: {}, base: { headEvents: [], bodyEvents: [] } }
+                GuJS.eventsCore[patch.AppID] = appEvents
+
+                var viewEvents = appEvents.views[patch.ViewID] || []
+
+                // Deregister all view events.
+                GuJS.each(viewEvents, function(cb) {
+                    body.removeEventListener(cb.Event.Event, cb.Callback)
+                })
+
+                viewEvents = []
+                appEvents.views[patch.ViewID] = viewEvents
+
+                GuJS.ApplyPatches(patch.Patches || [])
+
+                // Register all events for this markup.
+                GuJS.each(patch.Events || [], function(event) {
+                    var newEvent = {}
+                    newEvent.Event = event
+                    newEvent.Callback = GuJS.MakeEventCallback(body, event)
+
+                    body.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                    viewEvents.push(newEvent)
+                })
+
+                return
+
             default:
                 console.log("Command not support: ", command);
         }
@@ -382,6 +616,97 @@ function GuClient(onMessages, SendChannel) {
         }
     }
 
+    // GuJS.ApplyPatches applies the list of patches produced by trees.Diff in
+    // order on the live DOM.
+    GuJS.ApplyPatches = function(patches) {
+        GuJS.each(patches, function(patch) {
+            var target = document.querySelector(patch.Selector)
+            if (!target) {
+                return
+            }
+
+            switch (patch.Type) {
+                case "SetAttribute":
+                    target.setAttribute(patch.Name, patch.Value || "")
+                    return
+
+                case "RemoveAttribute":
+                    target.removeAttribute(patch.Name)
+                    return
+
+                case "SetStyle":
+                    target.style.setProperty(patch.Name, patch.Value || "")
+                    return
+
+                case "RemoveStyle":
+                    target.style.removeProperty(patch.Name)
+                    return
+
+                case "ReplaceText":
+                    if (patch.Index < 0) {
+                        target.textContent = patch.Value || ""
+                        return
+                    }
+
+                    var textNode = target.childNodes[patch.Index]
+                    if (textNode) {
+                        textNode.textContent = patch.Value || ""
+                    }
+
+                    return
+
+                case "InsertChild":
+                    var fragment = GuJS.createDOMFragment(patch.Markup)
+
+                    if (patch.Before) {
+                        var before = target.querySelector(patch.Before)
+                        if (before && before.parentNode === target) {
+                            target.insertBefore(fragment, before)
+                            return
+                        }
+                    }
+
+                    if (!patch.Before && patch.Index < target.childNodes.length) {
+                        target.insertBefore(fragment, target.childNodes[patch.Index])
+                        return
+                    }
+
+                    target.appendChild(fragment)
+                    return
+
+                case "RemoveChild":
+                    var child = patch.Target ? target.querySelector(patch.Target) : target.childNodes[patch.Index]
+                    if (child && child.parentNode === target) {
+                        target.removeChild(child)
+                    }
+
+                    return
+
+                case "MoveChild":
+                    var moved = target.querySelector(patch.Target)
+                    if (!moved) {
+                        return
+                    }
+
+                    var anchor = patch.Before ? target.querySelector(patch.Before) : null
+                    if (anchor && anchor.parentNode === target) {
+                        target.insertBefore(moved, anchor)
+                        return
+                    }
+
+                    target.appendChild(moved)
+                    return
+
+                case "ReplaceNode":
+                    if (target.parentNode) {
+                        target.parentNode.replaceChild(GuJS.createDOMFragment(patch.Markup), target)
+                    }
+
+                    return
+            }
+        })
+    }
+
     // addIfNoEqual adds a giving node into the target if its not found to match any
     // child nodes of the target and if one is found then that is replaced with the
     // provided new node.
@@ -475,6 +800,22 @@ function GuClient(onMessages, SendChannel) {
                 })
         }
 
+        // submitted forms carry the values of their fields, which are not
+        // part of the event itself.
+        if (ev.type === "submit" && ev.target instanceof HTMLFormElement) {
+            var values = {}
+
+            new FormData(ev.target).forEach(function(value, name) {
+                if (typeof value !== "string") {
+                    return
+                }
+
+                values[name] = (values[name] || []).concat(value)
+            })
+
+            eventObj.Values = values
+        }
+
         return eventObj
     }
 
@@ -241,6 +241,14 @@ func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*event
 			return nil, err
 		}
 
+		return events.NewBaseEvent(&eventObject, handle), nil
+	case "InputEvent":
+		var eventObject events.InputEvent
+
+		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
+			return nil, err
+		}
+
 		return events.NewBaseEvent(&eventObject, handle), nil
 	case "KeyboardEvent":
 		var eventObject events.KeyboardEvent
@@ -353,6 +361,14 @@ func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*event
 			return nil, err
 		}
 
+		return events.NewBaseEvent(&eventObject, handle), nil
+	case "SubmitEvent":
+		var eventObject events.SubmitEvent
+
+		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
+			return nil, err
+		}
+
 		return events.NewBaseEvent(&eventObject, handle), nil
 	case "SVGEvent":
 		var eventObject events.SVGEvent
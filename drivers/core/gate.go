@@ -0,0 +1,156 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu/common"
+	events "github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/trees"
+)
+
+// PendingEvent defines an event held back by an EventGate until its debounce
+// duration passes.
+type PendingEvent struct {
+	Meta  trees.EventJSON
+	Event interface{}
+	due   time.Time
+}
+
+// EventGate enforces the options of events on the Go side. Drivers which
+// simulate events without the core javascript client use Filter, enforcing
+// the Once, Keys, Throttle and Debounce options as the client does, with the
+// time of every occurrence provided by the driver, allowing drivers to use a
+// virtual clock. Drivers receiving events from the client use Allow.
+type EventGate struct {
+	ml      sync.Mutex
+	fired   map[string]bool
+	last    map[string]time.Time
+	pending map[string]PendingEvent
+}
+
+// NewEventGate returns a new instance of EventGate.
+func NewEventGate() *EventGate {
+	return &EventGate{
+		fired:   make(map[string]bool),
+		last:    make(map[string]time.Time),
+		pending: make(map[string]PendingEvent),
+	}
+}
+
+// Allow returns true/false if the event should be delivered, enforcing only
+// the Once and Keys options of the event. It is used by drivers receiving
+// events from the core javascript client, which debounces and throttles them
+// itself, where the options must be those of the event registered on the Go
+// side and never those sent by the client.
+func (g *EventGate) Allow(meta trees.EventJSON, event interface{}) bool {
+	if len(meta.Keys) != 0 && !hasKey(meta.Keys, event) {
+		return false
+	}
+
+	g.ml.Lock()
+	defer g.ml.Unlock()
+
+	if g.fired[meta.EventID] {
+		return false
+	}
+
+	if meta.Once {
+		g.fired[meta.EventID] = true
+	}
+
+	return true
+}
+
+// Filter returns true/false if the event occurring at the giving time should
+// be delivered now, where the event is a value of the eventx package or a
+// common.EventObject wrapping one. Debounced events are held back, replacing
+// any earlier occurrence still pending, and are returned by Due once their
+// debounce duration passes without another occurrence.
+func (g *EventGate) Filter(meta trees.EventJSON, event interface{}, now time.Time) bool {
+	if len(meta.Keys) != 0 && !hasKey(meta.Keys, event) {
+		return false
+	}
+
+	g.ml.Lock()
+	defer g.ml.Unlock()
+
+	if g.fired[meta.EventID] {
+		return false
+	}
+
+	if meta.Throttle > 0 {
+		last, ok := g.last[meta.EventID]
+		if ok && now.Sub(last) < time.Duration(meta.Throttle)*time.Millisecond {
+			return false
+		}
+
+		g.last[meta.EventID] = now
+	}
+
+	if meta.Debounce > 0 {
+		g.pending[meta.EventID] = PendingEvent{
+			Meta:  meta,
+			Event: event,
+			due:   now.Add(time.Duration(meta.Debounce) * time.Millisecond),
+		}
+
+		return false
+	}
+
+	if meta.Once {
+		g.fired[meta.EventID] = true
+	}
+
+	return true
+}
+
+// Due returns the debounced events whose debounce duration has passed at the
+// giving time, removing them from the gate.
+func (g *EventGate) Due(now time.Time) []PendingEvent {
+	g.ml.Lock()
+	defer g.ml.Unlock()
+
+	var due []PendingEvent
+
+	for id, pending := range g.pending {
+		if now.Before(pending.due) {
+			continue
+		}
+
+		delete(g.pending, id)
+
+		if g.fired[id] {
+			continue
+		}
+
+		if pending.Meta.Once {
+			g.fired[id] = true
+		}
+
+		due = append(due, pending)
+	}
+
+	return due
+}
+
+// hasKey returns true/false if the event is a keyboard event with one of the
+// giving keys.
+func hasKey(keys []string, event interface{}) bool {
+	if object, ok := event.(common.EventObject); ok {
+		event = object.Underlying()
+	}
+
+	keyboard, ok := event.(*events.KeyboardEvent)
+	if !ok {
+		return false
+	}
+
+	for _, key := range keys {
+		if key == keyboard.Key {
+			return true
+		}
+	}
+
+	return false
+}
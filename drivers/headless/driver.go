@@ -0,0 +1,466 @@
+// Package headless provides an in-memory driver for testing gu apps, which
+// mounts a gu.NApp without a browser and simulates DOM events like clicks,
+// input and form submissions against the handlers registered through the
+// trees/events package, applying the view updates they cause to the tree it
+// keeps.
+package headless
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+)
+
+// ErrNoTarget is returned when no element of the tree matches the selector of
+// a simulated event.
+var ErrNoTarget = errors.New("headless: no element matches the selector")
+
+// Driver defines an in-memory driver which mounts an app at a route and keeps
+// the tree rendered by it, re-rendering the views updated by the events it
+// dispatches.
+type Driver struct {
+	app *gu.NApp
+
+	ml   sync.Mutex
+	root *trees.Markup
+
+	// now is the virtual time of the driver, which only moves through Wait,
+	// used by gate to throttle and debounce events.
+	now  time.Time
+	gate *core.EventGate
+
+	viewUpdates common.Remover
+	appUpdates  common.Remover
+}
+
+// New mounts the app at the giving route, which is a url whose path is matched
+// against the routes of the views. The app is given a gu.MemoryLocation whose
+// history starts at the route once allowed by the guards of the app and its
+// views, so Back and Forward behave like the browser. A redirected route
+// renders the route it is sent to and a cancelled one renders no route.
+// The app is flushed by the driver after every event, through
+// gu.ManualScheduler. Throttled and debounced events are timed by a virtual
+// clock which only moves through Wait.
+func New(app *gu.NApp, route string) (*Driver, error) {
+	if _, err := router.NewPushEvent(route, false); err != nil {
+		return nil, err
+	}
+
+	d := &Driver{app: app, gate: core.NewEventGate()}
+
+	app.UseScheduler(gu.ManualScheduler)
+
+	d.viewUpdates = app.Notifications().NotifyWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		d.updateView(update.View)
+	}))
+
+	d.appUpdates = app.Notifications().NotifyWithRemover(gu.NewAppUpdateHandler(func(update gu.AppUpdate) {
+		d.ml.Lock()
+		d.root = app.Render(nil)
+		d.ml.Unlock()
+	}))
+
+	app.InitApp(gu.NewMemoryLocation(app, gu.DefaultHistorySize))
+
+	// The route goes through the guards of the app like any other navigation,
+	// replacing the current tree once they decide.
+	app.Load(router.PushDirectiveEvent{To: route}, nil)
+
+	d.ml.Lock()
+	d.root = app.Render(nil)
+	d.ml.Unlock()
+	app.Mounted()
+
+	return d, nil
+}
+
+// App returns the app mounted by the driver.
+func (d *Driver) App() *gu.NApp {
+	return d.app
+}
+
+// Root returns the current tree of the app.
+func (d *Driver) Root() *trees.Markup {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	return d.root
+}
+
+// Query returns the first element of the current tree matching the css
+// selector.
+func (d *Driver) Query(sel string) *trees.Markup {
+	return trees.Query.Query(d.Root(), sel)
+}
+
+// QueryAll returns the elements of the current tree matching the css
+// selector.
+func (d *Driver) QueryAll(sel string) []*trees.Markup {
+	return trees.Query.QueryAll(d.Root(), sel)
+}
+
+// Navigate navigates the app to the giving route through NApp.Navigate, which
+// runs the guards of the app and its views, replacing the current tree once
+// the navigation is allowed. Routes with a fragment are routed by their hash.
+func (d *Driver) Navigate(route string) error {
+	if _, err := router.NewPushEvent(route, false); err != nil {
+		return err
+	}
+
+	d.app.Navigate(router.PushDirectiveEvent{To: route})
+
+	return nil
+}
+
+// Back moves the app to the previous entry of its history, returning false if
+// there is none.
+func (d *Driver) Back() bool {
+	return d.app.Back()
+}
+
+// Forward moves the app to the next entry of its history, returning false if
+// there is none.
+func (d *Driver) Forward() bool {
+	return d.app.Forward()
+}
+
+// Unmount unmounts the app and stops applying its updates.
+func (d *Driver) Unmount() {
+	d.viewUpdates.Remove()
+	d.appUpdates.Remove()
+	d.app.Unmounted()
+}
+
+//==============================================================================
+
+// Click dispatches a 'click' event with an eventx.MouseEvent on the first
+// element matching the selector. Clicking a submit button within a form also
+// submits the form, unless a click handler prevents the default action.
+func (d *Driver) Click(sel string) error {
+	target := d.Query(sel)
+	if target == nil {
+		return fmt.Errorf("%s: %q", ErrNoTarget, sel)
+	}
+
+	prevented := d.dispatch(target, "click", &eventx.MouseEvent{UIEvent: &eventx.UIEvent{Detail: 1}})
+	if prevented || !submits(target) {
+		return nil
+	}
+
+	if form := closestForm(target); form != nil {
+		d.dispatch(form, "submit", &eventx.SubmitEvent{Values: formValues(form)})
+	}
+
+	return nil
+}
+
+// Input sets the value of the first element matching the selector and then
+// dispatches an 'input' event with an eventx.InputEvent holding the value.
+func (d *Driver) Input(sel string, value string) error {
+	target := d.Query(sel)
+	if target == nil {
+		return fmt.Errorf("%s: %q", ErrNoTarget, sel)
+	}
+
+	setValue(target, value)
+	d.dispatch(target, "input", &eventx.InputEvent{Data: value})
+
+	return nil
+}
+
+// Change sets the value of the first element matching the selector and then
+// dispatches a 'change' event with an eventx.ChangeEvent holding the value.
+func (d *Driver) Change(sel string, value string) error {
+	target := d.Query(sel)
+	if target == nil {
+		return fmt.Errorf("%s: %q", ErrNoTarget, sel)
+	}
+
+	setValue(target, value)
+	d.dispatch(target, "change", &eventx.ChangeEvent{Value: value})
+
+	return nil
+}
+
+// Press dispatches a 'keydown' event with an eventx.KeyboardEvent holding the
+// key on the first element matching the selector.
+func (d *Driver) Press(sel string, key string) error {
+	return d.Fire(sel, "keydown", &eventx.KeyboardEvent{Key: key})
+}
+
+// Submit dispatches a 'submit' event with an eventx.SubmitEvent holding the
+// values of its fields on the first element matching the selector.
+func (d *Driver) Submit(sel string) error {
+	target := d.Query(sel)
+	if target == nil {
+		return fmt.Errorf("%s: %q", ErrNoTarget, sel)
+	}
+
+	d.dispatch(target, "submit", &eventx.SubmitEvent{Values: formValues(target)})
+
+	return nil
+}
+
+// Fire dispatches an event of the giving type on the first element matching
+// the selector, with the giving value from the eventx package as the event
+// received by the handlers.
+func (d *Driver) Fire(sel string, eventType string, event interface{}) error {
+	target := d.Query(sel)
+	if target == nil {
+		return fmt.Errorf("%s: %q", ErrNoTarget, sel)
+	}
+
+	d.dispatch(target, eventType, event)
+
+	return nil
+}
+
+// Wait moves the virtual clock of the driver forward by the giving duration,
+// delivering the debounced events which became due.
+func (d *Driver) Wait(duration time.Duration) {
+	d.ml.Lock()
+	d.now = d.now.Add(duration)
+	now := d.now
+	d.ml.Unlock()
+
+	due := d.gate.Due(now)
+	for _, pending := range due {
+		d.app.Notifications().Handle(common.EventBroadcast{
+			EventName: pending.Meta.EventName,
+			EventID:   pending.Meta.EventID,
+			Event:     pending.Event.(common.EventObject),
+		})
+	}
+
+	if len(due) != 0 {
+		d.app.Flush()
+	}
+}
+
+//==============================================================================
+
+// dispatch delivers the event to the handlers of the events of the giving
+// type registered in the tree whose selectors match the target, as done by
+// the core javascript client, enforcing their delivery options through the
+// gate, and flushes the app. It returns true if any of
+// the handlers asked for the default action to be prevented.
+func (d *Driver) dispatch(target *trees.Markup, eventType string, event interface{}) bool {
+	root := d.Root()
+
+	var matched []trees.Event
+
+	root.EachEvent(func(ev *trees.Event, owner *trees.Markup) {
+		if ev.Type != eventType {
+			return
+		}
+
+		// the client listens for events on the head or body the event was
+		// rendered into, matching their selector within it.
+		scope := scopeOf(owner)
+		if scope == nil {
+			scope = root
+		}
+
+		for _, possible := range trees.Query.QueryAll(scope, ev.EventSelector()) {
+			if possible == target {
+				matched = append(matched, *ev)
+				return
+			}
+		}
+	})
+
+	d.ml.Lock()
+	now := d.now
+	d.ml.Unlock()
+
+	var prevented bool
+
+	for _, ev := range matched {
+		// passive listeners can not prevent the default action.
+		if ev.PreventDefault && !ev.Passive {
+			prevented = true
+		}
+
+		object := eventx.NewBaseEvent(event, nil)
+		if d.gate.Filter(ev.EventJSON(), object, now) {
+			d.app.Notifications().Handle(common.EventBroadcast{
+				EventName: ev.EventName(),
+				EventID:   ev.ID(),
+				Event:     object,
+			})
+		}
+
+		if ev.StopImmediatePropagation {
+			break
+		}
+	}
+
+	d.app.Flush()
+
+	return prevented
+}
+
+// updateView replaces the markup of the view within the current tree with a
+// new render of the view.
+func (d *Driver) updateView(view *gu.NView) {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	if d.root == nil {
+		return
+	}
+
+	var current *trees.Markup
+	walk(d.root, func(e *trees.Markup) bool {
+		if e.UID() == view.UUID() {
+			current = e
+			return true
+		}
+
+		return false
+	})
+
+	if current == nil {
+		return
+	}
+
+	trees.SelectMarkup(current).ReplaceWith(view.Render())
+}
+
+// walk calls the function for the markup and its children until it returns
+// true.
+func walk(e *trees.Markup, fn func(*trees.Markup) bool) bool {
+	if fn(e) {
+		return true
+	}
+
+	for _, child := range e.Children() {
+		if walk(child, fn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scopeOf returns the head or body element containing the markup.
+func scopeOf(e *trees.Markup) *trees.Markup {
+	return trees.SelectMarkup(e).Closest("head, body").First()
+}
+
+// closestForm returns the form containing the markup.
+func closestForm(e *trees.Markup) *trees.Markup {
+	return trees.SelectMarkup(e).Closest("form").First()
+}
+
+// submits returns true/false if clicking the element submits its form.
+func submits(e *trees.Markup) bool {
+	return trees.Query.Matches(e, `button:not([type]), button[type="submit" i], input[type="submit" i], input[type="image" i]`)
+}
+
+// formValues returns the values of the named fields of the form, leaving out
+// buttons and unchecked checkboxes and radios, with the selected options of
+// selects.
+func formValues(form *trees.Markup) map[string][]string {
+	values := make(map[string][]string)
+
+	trees.SelectMarkup(form).Select("input[name], textarea[name], select[name]").Each(func(_ int, field *trees.Markup) {
+		if trees.Query.Matches(field, `input[type="submit" i], input[type="button" i], input[type="reset" i], input[type="image" i]`) {
+			return
+		}
+
+		if trees.Query.Matches(field, `input[type="checkbox" i]:not([checked]), input[type="radio" i]:not([checked])`) {
+			return
+		}
+
+		name, _ := attrValue(field, "name")
+
+		switch field.Name() {
+		case "textarea":
+			values[name] = append(values[name], textValue(field))
+			return
+		case "select":
+			values[name] = append(values[name], selectedValues(field)...)
+			return
+		}
+
+		value, _ := attrValue(field, "value")
+		values[name] = append(values[name], value)
+	})
+
+	return values
+}
+
+// selectedValues returns the values of the selected options of the select. A
+// select without the multiple attribute has a single option selected, the
+// last one marked as selected or else its first option.
+func selectedValues(field *trees.Markup) []string {
+	options := trees.SelectMarkup(field).Select("option")
+	selected := options.Filter("[selected]").Markup()
+
+	if !trees.Query.Matches(field, "[multiple]") {
+		switch {
+		case len(selected) != 0:
+			selected = selected[len(selected)-1:]
+		case options.Len() != 0:
+			selected = []*trees.Markup{options.First()}
+		}
+	}
+
+	var values []string
+	for _, option := range selected {
+		value, ok := attrValue(option, "value")
+		if !ok {
+			value = strings.TrimSpace(textValue(option))
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// attrValue returns the value of the attribute of the markup with the giving
+// name.
+func attrValue(e *trees.Markup, name string) (string, bool) {
+	attr, err := trees.GetAttr(e, name)
+	if err != nil {
+		return "", false
+	}
+
+	_, value := attr.Render()
+	return value, true
+}
+
+// textValue returns the text of the markup and of its text children.
+func textValue(e *trees.Markup) string {
+	text := e.TextContent()
+
+	for _, child := range e.Children() {
+		if child.Kind() == trees.TextNode {
+			text += child.TextContent()
+		}
+	}
+
+	return text
+}
+
+// setValue sets the value of a form element, which is the text of a textarea
+// and the value attribute of other elements.
+func setValue(e *trees.Markup, value string) {
+	if e.Name() == "textarea" {
+		trees.SelectMarkup(e).SetText(value)
+		return
+	}
+
+	trees.SelectMarkup(e).SetAttr("value", value)
+}
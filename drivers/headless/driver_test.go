@@ -0,0 +1,354 @@
+package headless_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/headless"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/influx6/faux/tests"
+)
+
+type counter struct {
+	view   *gu.NView
+	count  int
+	name   string
+	sent   int
+	values map[string][]string
+	typed  int
+}
+
+func (c *counter) Render() *trees.Markup {
+	return elems.Div(
+		elems.Span(trees.NewAttr("class", "count"), elems.Text(strconv.Itoa(c.count))),
+		elems.Button(
+			trees.NewAttr("class", "inc"),
+			elems.Text("+"),
+			events.ClickEvent(func() {
+				c.count++
+				c.view.Publish()
+			}),
+		),
+		elems.Button(
+			trees.NewAttr("class", "typed"),
+			events.OnClick(func(*eventx.MouseEvent, *trees.Markup) {
+				c.typed++
+			}),
+		),
+		elems.Form(
+			events.OnSubmit(func(ev *eventx.SubmitEvent, _ *trees.Markup) {
+				c.sent++
+				c.values = ev.Values
+			}),
+			elems.Input(
+				trees.NewAttr("id", "name"),
+				trees.NewAttr("name", "name"),
+				events.OnInput(func(ev *eventx.InputEvent, _ *trees.Markup) {
+					c.name = ev.Data
+				}),
+			),
+			elems.Input(trees.NewAttr("type", "checkbox"), trees.NewAttr("name", "news"), trees.NewAttr("value", "weekly"), trees.NewAttr("checked", "")),
+			elems.Input(trees.NewAttr("type", "checkbox"), trees.NewAttr("name", "news"), trees.NewAttr("value", "daily")),
+			elems.Input(trees.NewAttr("type", "radio"), trees.NewAttr("name", "plan"), trees.NewAttr("value", "free")),
+			elems.Input(trees.NewAttr("type", "radio"), trees.NewAttr("name", "plan"), trees.NewAttr("value", "paid"), trees.NewAttr("checked", "")),
+			elems.Select(
+				trees.NewAttr("name", "country"),
+				elems.Option(trees.NewAttr("value", "fr"), elems.Text("France")),
+				elems.Option(trees.NewAttr("value", "ng"), trees.NewAttr("selected", ""), elems.Text("Nigeria")),
+			),
+			elems.Select(
+				trees.NewAttr("name", "size"),
+				elems.Option(elems.Text("Small")),
+				elems.Option(elems.Text("Large")),
+			),
+			elems.Select(
+				trees.NewAttr("name", "tags"),
+				trees.NewAttr("multiple", ""),
+				elems.Option(trees.NewAttr("value", "go"), trees.NewAttr("selected", "")),
+				elems.Option(trees.NewAttr("value", "js")),
+				elems.Option(trees.NewAttr("value", "css"), trees.NewAttr("selected", "")),
+			),
+			elems.Button(trees.NewAttr("class", "send"), elems.Text("Send")),
+			elems.Button(
+				trees.NewAttr("class", "cancel"),
+				elems.Text("Cancel"),
+				events.ClickEvent(func() {}, trees.PreventDefault(true)),
+			),
+		),
+	)
+}
+
+func newDriver(t *testing.T) (*headless.Driver, *counter) {
+	app := gu.App("Counter", nil)
+
+	component := new(counter)
+	component.view = app.View(elems.Section(), "/counter", gu.BodyTarget)
+	component.view.Component(component, gu.AnyOrder, "*", "")
+
+	driver, err := headless.New(app, "/counter")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	return driver, component
+}
+
+func TestClick(t *testing.T) {
+	driver, component := newDriver(t)
+	defer driver.Unmount()
+
+	if err := driver.Click("button.inc"); err != nil {
+		tests.Failed("Should have clicked the button: %s", err)
+	}
+	tests.Passed("Should have clicked the button")
+
+	if err := driver.Click("button.inc"); err != nil {
+		tests.Failed("Should have clicked the re-rendered button: %s", err)
+	}
+	tests.Passed("Should have clicked the re-rendered button")
+
+	if component.count != 2 {
+		tests.Failed("Should have called the click handler twice: %d", component.count)
+	}
+	tests.Passed("Should have called the click handler twice")
+
+	span := driver.Query("span.count")
+	if span == nil || len(span.Children()) != 1 || span.Children()[0].TextContent() != "2" {
+		tests.Failed("Should have updated the view after the clicks")
+	}
+	tests.Passed("Should have updated the view after the clicks")
+
+	driver.Click("button.typed")
+	driver.Fire("button.typed", "click", &eventx.BasicEventMap{})
+
+	if component.typed != 1 {
+		tests.Failed("Should have skipped the typed handler for an event of another struct: %d", component.typed)
+	}
+	tests.Passed("Should have skipped the typed handler for an event of another struct")
+
+	if err := driver.Click("button.missing"); err == nil {
+		tests.Failed("Should have failed to click a missing element")
+	}
+	tests.Passed("Should have failed to click a missing element")
+}
+
+func TestInput(t *testing.T) {
+	driver, component := newDriver(t)
+	defer driver.Unmount()
+
+	if err := driver.Input("#name", "Alex"); err != nil {
+		tests.Failed("Should have sent input to the element: %s", err)
+	}
+	tests.Passed("Should have sent input to the element")
+
+	if component.name != "Alex" {
+		tests.Failed("Should have received the input data in the handler: %q", component.name)
+	}
+	tests.Passed("Should have received the input data in the handler")
+
+	if driver.Query(`#name[value="Alex"]`) == nil {
+		tests.Failed("Should have set the value of the element")
+	}
+	tests.Passed("Should have set the value of the element")
+}
+
+func TestSubmit(t *testing.T) {
+	driver, component := newDriver(t)
+	defer driver.Unmount()
+
+	if err := driver.Click("button.send"); err != nil {
+		tests.Failed("Should have clicked the submit button: %s", err)
+	}
+	tests.Passed("Should have clicked the submit button")
+
+	if component.sent != 1 {
+		tests.Failed("Should have submitted the form: %d", component.sent)
+	}
+	tests.Passed("Should have submitted the form")
+
+	if values := component.values["name"]; len(values) != 1 || values[0] != "" {
+		tests.Failed("Should have submitted the values of the form: %#v", component.values)
+	}
+	tests.Passed("Should have submitted the values of the form")
+
+	if err := driver.Click("button.cancel"); err != nil {
+		tests.Failed("Should have clicked the cancel button: %s", err)
+	}
+	tests.Passed("Should have clicked the cancel button")
+
+	if component.sent != 1 {
+		tests.Failed("Should not have submitted the form for a prevented click: %d", component.sent)
+	}
+	tests.Passed("Should not have submitted the form for a prevented click")
+
+	driver.Input("#name", "Alex")
+
+	if err := driver.Submit("form"); err != nil || component.sent != 2 {
+		tests.Failed("Should have submitted the form directly: %d", component.sent)
+	}
+	tests.Passed("Should have submitted the form directly")
+
+	if values := component.values["name"]; len(values) != 1 || values[0] != "Alex" {
+		tests.Failed("Should have submitted the current values of the form: %#v", component.values)
+	}
+	tests.Passed("Should have submitted the current values of the form")
+
+	fields := map[string]string{
+		"news":    "weekly",
+		"plan":    "paid",
+		"country": "ng",
+		"size":    "Small",
+		"tags":    "go,css",
+	}
+
+	for name, want := range fields {
+		if values := strings.Join(component.values[name], ","); values != want {
+			tests.Failed("Should have submitted %q for the %q field: %#v", want, name, component.values)
+		}
+	}
+	tests.Passed("Should have submitted the checked inputs and selected options of the form")
+}
+
+type search struct {
+	queries []string
+	scrolls int
+	opened  int
+	keys    []string
+}
+
+func (s *search) Render() *trees.Markup {
+	return elems.Div(
+		elems.Input(
+			trees.NewAttr("id", "query"),
+			events.OnInput(func(ev *eventx.InputEvent, _ *trees.Markup) {
+				s.queries = append(s.queries, ev.Data)
+			}, trees.Debounce(300*time.Millisecond)),
+			events.OnKeyDown(func(ev *eventx.KeyboardEvent, _ *trees.Markup) {
+				s.keys = append(s.keys, ev.Key)
+			}, trees.OnKeys("Enter", "Escape")),
+		),
+		elems.Div(
+			trees.NewAttr("class", "results"),
+			events.ScrollEvent(func() {
+				s.scrolls++
+			}, trees.Throttle(100*time.Millisecond), trees.Passive()),
+		),
+		elems.Button(
+			trees.NewAttr("class", "open"),
+			events.ClickEvent(func() {
+				s.opened++
+			}, trees.Once()),
+		),
+	)
+}
+
+func TestEventOptions(t *testing.T) {
+	app := gu.App("Search", nil)
+
+	component := new(search)
+	app.View(component, "*", gu.BodyTarget)
+
+	driver, err := headless.New(app, "/")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	driver.Input("#query", "g")
+	driver.Input("#query", "gu")
+	driver.Wait(200 * time.Millisecond)
+	driver.Input("#query", "gui")
+
+	if len(component.queries) != 0 {
+		tests.Failed("Should have held back the debounced input: %q", component.queries)
+	}
+	tests.Passed("Should have held back the debounced input")
+
+	driver.Wait(300 * time.Millisecond)
+
+	if len(component.queries) != 1 || component.queries[0] != "gui" {
+		tests.Failed("Should have delivered the last debounced input: %q", component.queries)
+	}
+	tests.Passed("Should have delivered the last debounced input")
+
+	for _, key := range []string{"a", "Enter", "b", "Escape"} {
+		driver.Press("#query", key)
+	}
+
+	if len(component.keys) != 2 || component.keys[0] != "Enter" || component.keys[1] != "Escape" {
+		tests.Failed("Should have delivered only the filtered keys: %q", component.keys)
+	}
+	tests.Passed("Should have delivered only the filtered keys")
+
+	driver.Fire(".results", "scroll", &eventx.UIEvent{})
+	driver.Fire(".results", "scroll", &eventx.UIEvent{})
+	driver.Wait(100 * time.Millisecond)
+	driver.Fire(".results", "scroll", &eventx.UIEvent{})
+
+	if component.scrolls != 2 {
+		tests.Failed("Should have throttled the scroll events: %d", component.scrolls)
+	}
+	tests.Passed("Should have throttled the scroll events")
+
+	driver.Click("button.open")
+	driver.Click("button.open")
+
+	if component.opened != 1 {
+		tests.Failed("Should have delivered the click only once: %d", component.opened)
+	}
+	tests.Passed("Should have delivered the click only once")
+}
+
+func TestAppsKeepEventsApart(t *testing.T) {
+	var clicks [2]int
+
+	drivers := make([]*headless.Driver, 2)
+
+	for index := range drivers {
+		index := index
+
+		app := gu.App("Apart", nil)
+		app.UseIDs(trees.NewSequentialIDs("gu"))
+		app.View(elems.Div(
+			elems.Button(
+				trees.NewAttr("class", "hit"),
+				events.ClickEvent(func() {
+					clicks[index]++
+				}),
+			),
+		), "*", gu.BodyTarget)
+
+		driver, err := headless.New(app, "/")
+		if err != nil {
+			tests.Failed("Should have mounted the app: %s", err)
+		}
+
+		defer driver.Unmount()
+		drivers[index] = driver
+	}
+	tests.Passed("Should have mounted the apps")
+
+	if err := drivers[0].Click("button.hit"); err != nil {
+		tests.Failed("Should have clicked the button: %s", err)
+	}
+	tests.Passed("Should have clicked the button")
+
+	if drivers[0].Query("button.hit").UID() != drivers[1].Query("button.hit").UID() {
+		tests.Failed("Should have given the buttons of both apps the same uid")
+	}
+	tests.Passed("Should have given the buttons of both apps the same uid")
+
+	if clicks[0] != 1 || clicks[1] != 0 {
+		tests.Failed("Should have delivered the click only to the app it happened in: %v", clicks)
+	}
+	tests.Passed("Should have delivered the click only to the app it happened in")
+}
@@ -0,0 +1,392 @@
+package websocket
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// contains the opcodes of the websocket frames handled by Conn.
+const (
+	continuationFrame = 0x0
+	textFrame         = 0x1
+	binaryFrame       = 0x2
+	closeFrame        = 0x8
+	pingFrame         = 0x9
+	pongFrame         = 0xA
+)
+
+// protocolErrorStatus defines the status of the close frame sent when the
+// other end violates the protocol.
+var protocolErrorStatus = []byte{0x03, 0xEA}
+
+// acceptGUID defines the guid appended to the client key to produce the
+// Sec-WebSocket-Accept header as defined in RFC 6455.
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// MaxMessageSize defines the maximum size in bytes of a message read by a Conn.
+const MaxMessageSize = 1 << 20
+
+// contains the errors returned by Conn.
+var (
+	ErrBadHandshake     = errors.New("websocket: bad handshake")
+	ErrMessageTooLarge  = errors.New("websocket: message exceeds maximum size")
+	ErrProtocolViolated = errors.New("websocket: protocol violation")
+	ErrClosed           = errors.New("websocket: connection closed")
+)
+
+// Conn defines a websocket connection which reads and writes whole messages
+// as described by RFC 6455. Reads must happen from a single goroutine, while
+// writes are safe for concurrent use.
+type Conn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	client bool
+
+	wl     sync.Mutex
+	closed bool
+}
+
+// Upgrade upgrades the giving http request into a websocket connection,
+// returning an error without writing a response if the request is not a valid
+// websocket handshake.
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	if r.Method != http.MethodGet {
+		return nil, ErrBadHandshake
+	}
+
+	if !headerHas(r.Header, "Connection", "upgrade") || !headerHas(r.Header, "Upgrade", "websocket") {
+		return nil, ErrBadHandshake
+	}
+
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		return nil, ErrBadHandshake
+	}
+
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		return nil, ErrBadHandshake
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, errors.New("websocket: response does not support hijacking")
+	}
+
+	netConn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+
+	if _, err := netConn.Write([]byte(response)); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	return &Conn{conn: netConn, reader: rw.Reader}, nil
+}
+
+// Dial connects to the websocket server at the giving ws:// or wss:// url.
+func Dial(rawURL string) (*Conn, error) {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	host := target.Host
+
+	var netConn net.Conn
+
+	switch target.Scheme {
+	case "ws":
+		if target.Port() == "" {
+			host += ":80"
+		}
+
+		netConn, err = net.Dial("tcp", host)
+	case "wss":
+		if target.Port() == "" {
+			host += ":443"
+		}
+
+		netConn, err = tls.Dial("tcp", host, &tls.Config{ServerName: target.Hostname()})
+	default:
+		return nil, fmt.Errorf("websocket: unsupported scheme %q", target.Scheme)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	request := "GET " + target.RequestURI() + " HTTP/1.1\r\n" +
+		"Host: " + target.Host + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: " + key + "\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"
+
+	if _, err := netConn.Write([]byte(request)); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(netConn)
+
+	res, err := http.ReadResponse(reader, &http.Request{Method: http.MethodGet})
+	if err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusSwitchingProtocols || res.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		netConn.Close()
+		return nil, ErrBadHandshake
+	}
+
+	return &Conn{conn: netConn, reader: reader, client: true}, nil
+}
+
+// ReadMessage returns the next text or binary message received from the
+// connection, answering pings along the way. It returns io.EOF once the
+// other end closes the connection, and ErrProtocolViolated after closing the
+// connection with the status 1002 if the other end violates the protocol.
+func (c *Conn) ReadMessage() ([]byte, error) {
+	message, err := c.readMessage()
+	if err == ErrProtocolViolated {
+		c.writeFrame(closeFrame, protocolErrorStatus)
+	}
+
+	return message, err
+}
+
+// readMessage reads the frames of the next text or binary message.
+func (c *Conn) readMessage() ([]byte, error) {
+	var message []byte
+	var started bool
+
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case pingFrame:
+			if err := c.writeFrame(pongFrame, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case pongFrame:
+			continue
+		case closeFrame:
+			c.writeFrame(closeFrame, payload)
+			return nil, io.EOF
+		case textFrame, binaryFrame:
+			if started {
+				return nil, ErrProtocolViolated
+			}
+
+			started = true
+		case continuationFrame:
+			if !started {
+				return nil, ErrProtocolViolated
+			}
+		default:
+			return nil, ErrProtocolViolated
+		}
+
+		if len(message)+len(payload) > MaxMessageSize {
+			return nil, ErrMessageTooLarge
+		}
+
+		message = append(message, payload...)
+
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// WriteMessage writes the giving data as a single text message.
+func (c *Conn) WriteMessage(data []byte) error {
+	return c.writeFrame(textFrame, data)
+}
+
+// Close sends a close frame to the other end and closes the connection.
+func (c *Conn) Close() error {
+	c.writeFrame(closeFrame, []byte{0x03, 0xE8})
+	return c.conn.Close()
+}
+
+// readFrame reads a single frame from the connection, unmasking its payload.
+func (c *Conn) readFrame() (bool, byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	fin := header[0]&0x80 != 0
+	rsv := header[0] & 0x70
+	opcode := header[0] & 0x0F
+	masked := header[1]&0x80 != 0
+
+	// Clients must mask all frames while servers must never do so.
+	if masked == c.client {
+		return false, 0, nil, ErrProtocolViolated
+	}
+
+	// No extension is negotiated, leaving the reserved bits unset.
+	if rsv != 0 {
+		return false, 0, nil, ErrProtocolViolated
+	}
+
+	length := uint64(header[1] & 0x7F)
+
+	// Control frames can not be fragmented and carry at most 125 bytes.
+	if opcode&0x8 != 0 && (!fin || length > 125) {
+		return false, 0, nil, ErrProtocolViolated
+	}
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	if length > MaxMessageSize {
+		return false, 0, nil, ErrMessageTooLarge
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+
+	if masked {
+		maskBytes(mask, payload)
+	}
+
+	return fin, opcode, payload, nil
+}
+
+// writeFrame writes the payload as a single frame of the giving opcode,
+// masking it when the connection is a client.
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	c.wl.Lock()
+	defer c.wl.Unlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+
+	if opcode == closeFrame {
+		c.closed = true
+	}
+
+	frame := []byte{0x80 | opcode}
+
+	var maskBit byte
+	if c.client {
+		maskBit = 0x80
+	}
+
+	switch length := len(payload); {
+	case length < 126:
+		frame = append(frame, maskBit|byte(length))
+	case length <= 0xFFFF:
+		frame = append(frame, maskBit|126, byte(length>>8), byte(length))
+	default:
+		var ext [8]byte
+		binary.BigEndian.PutUint64(ext[:], uint64(length))
+		frame = append(frame, maskBit|127)
+		frame = append(frame, ext[:]...)
+	}
+
+	if c.client {
+		var mask [4]byte
+		if _, err := rand.Read(mask[:]); err != nil {
+			return err
+		}
+
+		masked := make([]byte, len(payload))
+		copy(masked, payload)
+		maskBytes(mask, masked)
+
+		frame = append(frame, mask[:]...)
+		payload = masked
+	}
+
+	frame = append(frame, payload...)
+
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// maskBytes applies the masking key to the giving data in place.
+func maskBytes(mask [4]byte, data []byte) {
+	for index := range data {
+		data[index] ^= mask[index%4]
+	}
+}
+
+// acceptKey returns the Sec-WebSocket-Accept value for the giving client key.
+func acceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// headerHas returns true/false if the giving header contains the token in its
+// comma separated values, ignoring case.
+func headerHas(header http.Header, name string, token string) bool {
+	for _, value := range header[name] {
+		for _, item := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(item), token) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,154 @@
+package websocket_test
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/drivers/websocket"
+	"github.com/influx6/faux/tests"
+)
+
+// dialRaw performs the websocket handshake with the giving server, returning
+// the underlying connection to write frames on directly.
+func dialRaw(server *httptest.Server) (net.Conn, *bufio.Reader, error) {
+	conn, err := net.Dial("tcp", strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := "GET / HTTP/1.1\r\n" +
+		"Host: " + strings.TrimPrefix(server.URL, "http://") + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"
+
+	if _, err := conn.Write([]byte(request)); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	if _, err := http.ReadResponse(reader, &http.Request{Method: http.MethodGet}); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	return conn, reader, nil
+}
+
+// maskedFrame returns a client frame with the giving first header byte and
+// payload, masked with a zero key.
+func maskedFrame(first byte, payload []byte) []byte {
+	frame := []byte{first}
+
+	if len(payload) < 126 {
+		frame = append(frame, 0x80|byte(len(payload)))
+	} else {
+		frame = append(frame, 0x80|126, byte(len(payload)>>8), byte(len(payload)))
+	}
+
+	frame = append(frame, 0, 0, 0, 0)
+	return append(frame, payload...)
+}
+
+func TestConnProtocolViolations(t *testing.T) {
+	frames := map[string][]byte{
+		"fragmented ping":  maskedFrame(0x09, nil),
+		"fragmented close": maskedFrame(0x08, []byte{0x03, 0xE8}),
+		"long ping":        maskedFrame(0x89, make([]byte, 126)),
+		"long pong":        maskedFrame(0x8A, make([]byte, 126)),
+		"long close":       maskedFrame(0x88, make([]byte, 126)),
+		"rsv1 text":        maskedFrame(0xC1, []byte("hello")),
+		"rsv2 text":        maskedFrame(0xA1, []byte("hello")),
+		"rsv3 ping":        maskedFrame(0x99, nil),
+	}
+
+	for name, frame := range frames {
+		errs := make(chan error, 1)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := websocket.Upgrade(w, r)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			defer conn.Close()
+
+			_, err = conn.ReadMessage()
+			errs <- err
+		}))
+
+		conn, reader, err := dialRaw(server)
+		if err != nil {
+			tests.Failed("Should have connected to the server: %q", err.Error())
+		}
+
+		if _, err := conn.Write(frame); err != nil {
+			tests.Failed("Should have written the %s frame: %q", name, err.Error())
+		}
+
+		select {
+		case err := <-errs:
+			if err != websocket.ErrProtocolViolated {
+				tests.Failed("Should have rejected the %s frame: %v", name, err)
+			}
+		case <-time.After(time.Second):
+			tests.Failed("Should have rejected the %s frame", name)
+		}
+
+		reply := make([]byte, 4)
+		if _, err := io.ReadFull(reader, reply); err != nil || !bytes.Equal(reply, []byte{0x88, 0x02, 0x03, 0xEA}) {
+			tests.Failed("Should have closed the connection with status 1002 for the %s frame: %#v", name, reply)
+		}
+
+		conn.Close()
+		server.Close()
+	}
+	tests.Passed("Should have closed the connection with status 1002 for invalid frames")
+}
+
+func TestConnControlFrames(t *testing.T) {
+	messages := make(chan string, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Upgrade(w, r)
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		message, _ := conn.ReadMessage()
+		messages <- string(message)
+	}))
+	defer server.Close()
+
+	conn, reader, err := dialRaw(server)
+	if err != nil {
+		tests.Failed("Should have connected to the server: %q", err.Error())
+	}
+	defer conn.Close()
+
+	conn.Write(maskedFrame(0x89, make([]byte, 125)))
+	conn.Write(maskedFrame(0x81, []byte("hello")))
+
+	pong := make([]byte, 127)
+	if _, err := io.ReadFull(reader, pong); err != nil || pong[0] != 0x8A || pong[1] != 125 {
+		tests.Failed("Should have answered a ping of 125 bytes: %#v", pong[:2])
+	}
+	tests.Passed("Should have answered a ping of 125 bytes")
+
+	if message := <-messages; message != "hello" {
+		tests.Failed("Should have read the message after the ping: %q", message)
+	}
+	tests.Passed("Should have read the message after the ping")
+}
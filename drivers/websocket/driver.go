@@ -0,0 +1,453 @@
+// Package websocket provides a server driver which hosts a gu.NApp for every
+// browser session, serving its initial markup over http and streaming its
+// updates as RenderCommand messages over a websocket connection, while events
+// from the browser are routed back into the app.
+package websocket
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/events"
+)
+
+// SocketPath defines the path on which the driver upgrades browser sessions
+// into websocket connections.
+const SocketPath = "/gu-socket"
+
+// bootstrap defines the script added into every served page which connects
+// the core javascript client to the session's websocket.
+const bootstrap = `(function(){
+	var scheme = window.location.protocol === "https:" ? "wss://" : "ws://";
+	var socket = new WebSocket(scheme + window.location.host + %q);
+	var pending = [];
+
+	socket.onopen = function(){
+		for (var i = 0; i < pending.length; i++) {
+			socket.send(pending[i]);
+		}
+
+		pending = [];
+	};
+
+	GuClient(function(execute){
+		socket.onmessage = function(message){
+			execute(message.data);
+		};
+	}, function(message){
+		var data = JSON.stringify(message);
+
+		if (socket.readyState !== 1) {
+			pending.push(data);
+			return;
+		}
+
+		socket.send(data);
+	});
+})();`
+
+// HydrateMismatchMessage defines the type of the message sent by the core
+// javascript client with a gu.HydrationReport when the served page differed
+// from the app it hydrated.
+const HydrateMismatchMessage = "HydrateMismatch"
+
+// DefaultSessionTimeout defines the duration after which a session whose page
+// never connected to it is dropped.
+const DefaultSessionTimeout = time.Minute
+
+// AppMaker defines a function which returns a new NApp for a browser session.
+type AppMaker func() *gu.NApp
+
+// EventMessage defines the message sent by the core javascript client when a
+// registered event occurs in the browser.
+type EventMessage struct {
+	Type string          `json:"type"`
+	Meta trees.EventJSON `json:"meta"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Driver defines a http.Handler which serves the apps created by its AppMaker,
+// where each page request creates a new session which the page then connects
+// to through SocketPath.
+type Driver struct {
+	maker    AppMaker
+	timeout  time.Duration
+	ml       sync.Mutex
+	sessions map[string]*Session
+}
+
+// New returns a new instance of a Driver using the provided AppMaker.
+func New(maker AppMaker) *Driver {
+	return &Driver{
+		maker:    maker,
+		timeout:  DefaultSessionTimeout,
+		sessions: make(map[string]*Session),
+	}
+}
+
+// UseSessionTimeout sets the duration after which a session whose page never
+// connected to it is dropped, in place of DefaultSessionTimeout.
+func (d *Driver) UseSessionTimeout(timeout time.Duration) {
+	d.ml.Lock()
+	d.timeout = timeout
+	d.ml.Unlock()
+}
+
+// ServeHTTP serves the websocket connection of a session for requests to
+// SocketPath, else renders a new session's app for the requested url.
+func (d *Driver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == SocketPath {
+		d.serveSocket(w, r)
+		return
+	}
+
+	d.servePage(w, r)
+}
+
+// Session returns the session with the giving id, if it exists.
+func (d *Driver) Session(id string) (*Session, bool) {
+	d.ml.Lock()
+	defer d.ml.Unlock()
+
+	session, ok := d.sessions[id]
+	return session, ok
+}
+
+// servePage renders the app of a new session for the requested url, which
+// starts the history of the session's gu.MemoryLocation once allowed by the
+// guards of the app.
+func (d *Driver) servePage(w http.ResponseWriter, r *http.Request) {
+	if _, err := router.NewPushEvent(r.URL.String(), false); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, err := newSessionID()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	session := &Session{id: id, app: d.maker(), gate: core.NewEventGate()}
+
+	script := trees.NewMarkup("script", false)
+	trees.NewAttr("type", "text/javascript").Apply(script)
+	trees.NewText(fmt.Sprintf(bootstrap, SocketPath+"?session="+id)).Apply(script)
+	session.app.AddAsset(script, gu.BodyTarget)
+
+	session.app.InitApp(gu.NewMemoryLocation(session.app, gu.DefaultHistorySize))
+
+	// The requested url goes through the guards of the app like any other
+	// navigation, so the page renders the route it is redirected to, or no
+	// route if cancelled.
+	loaded := make(chan struct{})
+	session.app.Load(router.PushDirectiveEvent{To: r.URL.String()}, func(bool) {
+		close(loaded)
+	})
+
+	select {
+	case <-loaded:
+	case <-r.Context().Done():
+		return
+	}
+
+	d.ml.Lock()
+	d.sessions[id] = session
+	timeout := d.timeout
+	d.ml.Unlock()
+
+	time.AfterFunc(timeout, func() {
+		d.expire(session)
+	})
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	io.WriteString(w, "<!doctype html>")
+	session.app.RenderTo(w, nil)
+}
+
+// expire drops the session if its page has not connected to it, claiming it
+// so a later connection is refused.
+func (d *Driver) expire(session *Session) {
+	if !session.claim() {
+		return
+	}
+
+	d.ml.Lock()
+	delete(d.sessions, session.id)
+	d.ml.Unlock()
+}
+
+// serveSocket upgrades the request into the websocket connection of the
+// session provided by the 'session' query parameter, serving it until closed.
+// Requests from browsers on another origin than the one serving the page are
+// refused, as the session id alone does not prove where the request came from.
+func (d *Driver) serveSocket(w http.ResponseWriter, r *http.Request) {
+	if !sameOrigin(r) {
+		http.Error(w, "Origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	id := r.URL.Query().Get("session")
+
+	session, ok := d.Session(id)
+	if !ok {
+		http.Error(w, "Unknown session", http.StatusNotFound)
+		return
+	}
+
+	if !session.claim() {
+		http.Error(w, "Session already connected", http.StatusConflict)
+		return
+	}
+
+	conn, err := Upgrade(w, r)
+	if err != nil {
+		session.release()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	defer func() {
+		d.ml.Lock()
+		delete(d.sessions, id)
+		d.ml.Unlock()
+	}()
+
+	session.serve(conn)
+}
+
+// sameOrigin returns true/false if the Origin header of the request, set by
+// browsers on websocket requests, matches the host the request was sent to.
+// Requests without an Origin header do not come from browsers and are allowed.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	target, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(target.Host, r.Host)
+}
+
+// newSessionID returns a new random session id.
+func newSessionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+//==============================================================================
+
+// Session defines a browser session which holds the app rendered for it and
+// the websocket connection it is served over.
+type Session struct {
+	id  string
+	app *gu.NApp
+
+	// aml guards the app, which is used from both the connection's reading
+	// goroutine and the frames flushed by the app's scheduler.
+	aml sync.Mutex
+
+	// gate enforces the Once and Keys options of the events sent by the client.
+	gate *core.EventGate
+
+	ml      sync.Mutex
+	claimed bool
+	conn    *Conn
+}
+
+// ID returns the id of the session.
+func (s *Session) ID() string {
+	return s.id
+}
+
+// App returns the app rendered for the session.
+func (s *Session) App() *gu.NApp {
+	return s.app
+}
+
+// Send writes the giving command into the session's connection.
+func (s *Session) Send(command gu.RenderCommand) error {
+	data, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+
+	s.ml.Lock()
+	conn := s.conn
+	s.ml.Unlock()
+
+	if conn == nil {
+		return ErrClosed
+	}
+
+	return conn.WriteMessage(data)
+}
+
+// claim marks the session as connected, returning false if it already was.
+func (s *Session) claim() bool {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	if s.claimed {
+		return false
+	}
+
+	s.claimed = true
+	return true
+}
+
+// release unmarks the session as connected.
+func (s *Session) release() {
+	s.ml.Lock()
+	s.claimed = false
+	s.ml.Unlock()
+}
+
+// serve streams the render commands of the session's app into the connection
+// and routes the events read from it, until the connection is closed.
+func (s *Session) serve(conn *Conn) {
+	s.ml.Lock()
+	s.conn = conn
+	s.ml.Unlock()
+
+	defer conn.Close()
+
+	viewUpdates := s.app.Notifications().NotifyWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		s.Send(gu.ViewPatchCommand(update.View))
+	}))
+
+	defer viewUpdates.Remove()
+
+	appUpdates := s.app.Notifications().NotifyWithRemover(gu.NewAppUpdateHandler(func(update gu.AppUpdate) {
+		s.Send(gu.AppRenderCommand(s.app, nil))
+	}))
+
+	defer appUpdates.Remove()
+
+	// Updates published outside of events are flushed at the end of a frame,
+	// while those published by events are flushed once the event is handled.
+	s.app.UseScheduler(gu.SchedulerFunc(func(flush func()) {
+		time.AfterFunc(gu.DefaultFrame, func() {
+			s.aml.Lock()
+			defer s.aml.Unlock()
+
+			flush()
+		})
+	}))
+
+	// Hydrate the served page for the client to register the app's events, as
+	// the page only carries its markup.
+	s.aml.Lock()
+	command := gu.HydrateAppCommand(s.app)
+	s.aml.Unlock()
+
+	if err := s.Send(command); err != nil {
+		return
+	}
+
+	s.aml.Lock()
+	s.app.Mounted()
+	s.aml.Unlock()
+
+	defer func() {
+		s.aml.Lock()
+		s.app.Unmounted()
+		s.aml.Unlock()
+	}()
+
+	for {
+		data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message EventMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			continue
+		}
+
+		if message.Type == HydrateMismatchMessage {
+			s.report(message)
+			continue
+		}
+
+		s.dispatch(message)
+	}
+}
+
+// report delivers the hydration report of the message into the notifications
+// of the session's app.
+func (s *Session) report(message EventMessage) {
+	var report gu.HydrationReport
+	if err := json.Unmarshal(message.Data, &report); err != nil {
+		return
+	}
+
+	s.aml.Lock()
+	defer s.aml.Unlock()
+
+	s.app.Notifications().Handle(report)
+}
+
+// dispatch routes the event message to the handler of the event it targets,
+// flushing the views updated by it. Events are dispatched through the
+// notifications of the app, which delivers them to the handlers of the events
+// of its views matching their unique id. Events with typed handlers are decoded into their eventx struct.
+// The options of the event are taken from the event registered with the id in
+// the app and never from the message, where only the Once and Keys options are
+// enforced, the client debouncing and throttling events itself. Messages for
+// events not rendered by the app are dropped.
+func (s *Session) dispatch(message EventMessage) {
+	s.aml.Lock()
+	defer s.aml.Unlock()
+
+	registered, ok := s.app.Event(message.Meta.EventID)
+	if !ok {
+		return
+	}
+
+	meta := registered.EventJSON()
+
+	name := meta.EventName
+	if structName, ok := events.EventStructs[meta.Event]; ok {
+		name = structName
+	}
+
+	event, err := core.GetEvent(name, message.Data, nil)
+	if err != nil {
+		return
+	}
+
+	if !s.gate.Allow(meta, event) {
+		return
+	}
+
+	s.app.Notifications().Handle(common.EventBroadcast{
+		EventName: meta.EventName,
+		EventID:   meta.EventID,
+		Event:     event,
+	})
+
+	s.app.Flush()
+}
@@ -0,0 +1,431 @@
+package websocket_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/websocket"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/influx6/faux/tests"
+)
+
+var sessionID = regexp.MustCompile(`session=([0-9a-f]+)`)
+
+func readCommand(conn *websocket.Conn) (gu.RenderCommand, error) {
+	var command gu.RenderCommand
+
+	data, err := conn.ReadMessage()
+	if err != nil {
+		return command, err
+	}
+
+	err = json.Unmarshal(data, &command)
+	return command, err
+}
+
+func TestDriver(t *testing.T) {
+	clicks := make(chan float64, 1)
+
+	var view *gu.NView
+
+	server := httptest.NewServer(websocket.New(func() *gu.NApp {
+		app := gu.App("Counter", nil)
+
+		view = app.View(elems.Div(
+			elems.Button(
+				elems.Text("Click"),
+				events.OnClick(func(ev *eventx.MouseEvent, _ *trees.Markup) {
+					clicks <- ev.ClientX
+				}),
+			),
+		), "*", gu.BodyTarget)
+
+		return app
+	}))
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/")
+	if err != nil {
+		tests.Failed("Should have successfully requested the page: %q", err.Error())
+	}
+	tests.Passed("Should have successfully requested the page")
+
+	page, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		tests.Failed("Should have successfully read the page: %q", err.Error())
+	}
+	tests.Passed("Should have successfully read the page")
+
+	if !strings.Contains(string(page), "<button") {
+		tests.Failed("Should have rendered the app's views into the page")
+	}
+	tests.Passed("Should have rendered the app's views into the page")
+
+	match := sessionID.FindStringSubmatch(string(page))
+	if match == nil {
+		tests.Failed("Should have rendered the session into the page")
+	}
+	tests.Passed("Should have rendered the session into the page")
+
+	socketURL := "ws" + strings.TrimPrefix(server.URL, "http") + websocket.SocketPath + "?session=" + match[1]
+
+	conn, err := websocket.Dial(socketURL)
+	if err != nil {
+		tests.Failed("Should have successfully connected to the session: %q", err.Error())
+	}
+	tests.Passed("Should have successfully connected to the session")
+
+	defer conn.Close()
+
+	if _, err := websocket.Dial(socketURL); err == nil {
+		tests.Failed("Should have refused a second connection to the session")
+	}
+	tests.Passed("Should have refused a second connection to the session")
+
+	command, err := readCommand(conn)
+	if err != nil {
+		tests.Failed("Should have successfully received the app: %q", err.Error())
+	}
+	tests.Passed("Should have successfully received the app")
+
+	if command.Command != "HydrateApp" || len(command.App.Body) != 1 {
+		tests.Failed("Should have received a HydrateApp command: %#v", command)
+	}
+	tests.Passed("Should have received a HydrateApp command")
+
+	if !strings.Contains(string(page), command.App.Body[0].Tree.Markup) {
+		tests.Failed("Should have received the markup of the served page")
+	}
+	tests.Passed("Should have received the markup of the served page")
+
+	viewEvents := command.App.Body[0].Tree.Events
+	if len(viewEvents) != 1 || viewEvents[0].Event != "click" {
+		tests.Failed("Should have received the click event of the view: %#v", viewEvents)
+	}
+	tests.Passed("Should have received the click event of the view")
+
+	message, err := json.Marshal(websocket.EventMessage{
+		Type: viewEvents[0].EventName,
+		Meta: viewEvents[0],
+		Data: json.RawMessage(`{"Type":"click","ClientX":12}`),
+	})
+	if err != nil {
+		tests.Failed("Should have successfully encoded the event: %q", err.Error())
+	}
+	tests.Passed("Should have successfully encoded the event")
+
+	if err := conn.WriteMessage(message); err != nil {
+		tests.Failed("Should have successfully sent the event: %q", err.Error())
+	}
+	tests.Passed("Should have successfully sent the event")
+
+	select {
+	case x := <-clicks:
+		if x != 12 {
+			tests.Failed("Should have decoded the event as a MouseEvent: %v", x)
+		}
+		tests.Passed("Should have routed the event to its handler")
+	case <-time.After(2 * time.Second):
+		tests.Failed("Should have routed the event to its handler")
+	}
+
+	view.Publish()
+
+	command, err = readCommand(conn)
+	if err != nil {
+		tests.Failed("Should have successfully received the view: %q", err.Error())
+	}
+	tests.Passed("Should have successfully received the view")
+
+	if command.Command != "PatchView" || command.Patch.ViewID != view.UUID() {
+		tests.Failed("Should have received a PatchView command for the view: %#v", command)
+	}
+	tests.Passed("Should have received a PatchView command for the view")
+}
+
+func TestDriverUnknownSession(t *testing.T) {
+	server := httptest.NewServer(websocket.New(func() *gu.NApp {
+		return gu.App("Empty", nil)
+	}))
+	defer server.Close()
+
+	socketURL := "ws" + strings.TrimPrefix(server.URL, "http") + websocket.SocketPath + "?session=unknown"
+
+	if _, err := websocket.Dial(socketURL); err == nil {
+		tests.Failed("Should have refused connection to an unknown session")
+	}
+	tests.Passed("Should have refused connection to an unknown session")
+}
+
+func TestDriverSessionTimeout(t *testing.T) {
+	driver := websocket.New(func() *gu.NApp {
+		return gu.App("Expiring", nil)
+	})
+	driver.UseSessionTimeout(10 * time.Millisecond)
+
+	server := httptest.NewServer(driver)
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/")
+	if err != nil {
+		tests.Failed("Should have successfully requested the page: %q", err.Error())
+	}
+
+	page, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		tests.Failed("Should have successfully read the page: %q", err.Error())
+	}
+
+	match := sessionID.FindStringSubmatch(string(page))
+	if match == nil {
+		tests.Failed("Should have rendered the session into the page")
+	}
+
+	if _, ok := driver.Session(match[1]); !ok {
+		tests.Failed("Should have kept the session until it expires")
+	}
+	tests.Passed("Should have kept the session until it expires")
+
+	time.Sleep(50 * time.Millisecond)
+
+	if _, ok := driver.Session(match[1]); ok {
+		tests.Failed("Should have dropped the session its page never connected to")
+	}
+	tests.Passed("Should have dropped the session its page never connected to")
+
+	socketURL := "ws" + strings.TrimPrefix(server.URL, "http") + websocket.SocketPath + "?session=" + match[1]
+	if _, err := websocket.Dial(socketURL); err == nil {
+		tests.Failed("Should have refused connection to an expired session")
+	}
+	tests.Passed("Should have refused connection to an expired session")
+}
+
+func TestDriverOrigin(t *testing.T) {
+	server := httptest.NewServer(websocket.New(func() *gu.NApp {
+		return gu.App("Origin", nil)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest("GET", server.URL+websocket.SocketPath+"?session=unknown", nil)
+	if err != nil {
+		tests.Failed("Should have successfully created the request: %q", err.Error())
+	}
+
+	req.Header.Set("Origin", "http://attacker.example")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		tests.Failed("Should have successfully sent the request: %q", err.Error())
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusForbidden {
+		tests.Failed("Should have refused the upgrade from another origin: %d", res.StatusCode)
+	}
+	tests.Passed("Should have refused the upgrade from another origin")
+
+	req.Header.Set("Origin", server.URL)
+
+	res, err = http.DefaultClient.Do(req)
+	if err != nil {
+		tests.Failed("Should have successfully sent the request: %q", err.Error())
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusNotFound {
+		tests.Failed("Should have checked the session of a same origin upgrade: %d", res.StatusCode)
+	}
+	tests.Passed("Should have checked the session of a same origin upgrade")
+}
+
+func TestDriverSessionIsolation(t *testing.T) {
+	var apps []*gu.NApp
+	var views []*gu.NView
+
+	server := httptest.NewServer(websocket.New(func() *gu.NApp {
+		app := gu.App("Isolated", nil)
+		views = append(views, app.View(elems.Div(), "*", gu.BodyTarget))
+		apps = append(apps, app)
+		return app
+	}))
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		res, err := http.Get(server.URL + "/")
+		if err != nil {
+			tests.Failed("Should have successfully requested the page: %q", err.Error())
+		}
+		res.Body.Close()
+	}
+	tests.Passed("Should have successfully requested two sessions")
+
+	updates := make([]int, len(apps))
+	for index, app := range apps {
+		index := index
+		app.Notifications().Notify(gu.NewViewUpdateHandler(func(gu.ViewUpdate) {
+			updates[index]++
+		}))
+	}
+
+	views[0].Publish()
+
+	for _, app := range apps {
+		app.Flush()
+	}
+
+	if updates[0] != 1 {
+		tests.Failed("Should have delivered the update to the view's app: %d", updates[0])
+	}
+	tests.Passed("Should have delivered the update to the view's app")
+
+	if updates[1] != 0 {
+		tests.Failed("Should not have delivered the update to another app: %d", updates[1])
+	}
+	tests.Passed("Should not have delivered the update to another app")
+}
+
+func TestDriverEventOptions(t *testing.T) {
+	clicks := make(chan string, 4)
+
+	server := httptest.NewServer(websocket.New(func() *gu.NApp {
+		app := gu.App("Options", nil)
+
+		app.View(elems.Div(
+			elems.Button(
+				elems.Text("Once"),
+				events.OnClick(func(*eventx.MouseEvent, *trees.Markup) {
+					clicks <- "once"
+				}, trees.Once()),
+			),
+			elems.Button(
+				elems.Text("Done"),
+				events.OnClick(func(*eventx.MouseEvent, *trees.Markup) {
+					clicks <- "done"
+				}),
+			),
+		), "*", gu.BodyTarget)
+
+		return app
+	}))
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/")
+	if err != nil {
+		tests.Failed("Should have successfully requested the page: %q", err.Error())
+	}
+
+	page, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		tests.Failed("Should have successfully read the page: %q", err.Error())
+	}
+
+	match := sessionID.FindStringSubmatch(string(page))
+	if match == nil {
+		tests.Failed("Should have rendered the session into the page")
+	}
+
+	conn, err := websocket.Dial("ws" + strings.TrimPrefix(server.URL, "http") + websocket.SocketPath + "?session=" + match[1])
+	if err != nil {
+		tests.Failed("Should have successfully connected to the session: %q", err.Error())
+	}
+	defer conn.Close()
+
+	command, err := readCommand(conn)
+	if err != nil {
+		tests.Failed("Should have successfully received the app: %q", err.Error())
+	}
+
+	viewEvents := command.App.Body[0].Tree.Events
+	if len(viewEvents) != 2 || !viewEvents[0].Once {
+		tests.Failed("Should have received the events of the view: %#v", viewEvents)
+	}
+	tests.Passed("Should have received the events of the view")
+
+	send := func(meta trees.EventJSON) {
+		message, err := json.Marshal(websocket.EventMessage{
+			Type: meta.EventName,
+			Meta: meta,
+			Data: json.RawMessage(`{"Type":"click"}`),
+		})
+		if err != nil {
+			tests.Failed("Should have successfully encoded the event: %q", err.Error())
+		}
+
+		if err := conn.WriteMessage(message); err != nil {
+			tests.Failed("Should have successfully sent the event: %q", err.Error())
+		}
+	}
+
+	forged := viewEvents[0]
+	forged.Once = false
+
+	unknown := viewEvents[1]
+	unknown.EventID = "unknown"
+
+	send(forged)
+	send(forged)
+	send(unknown)
+	send(viewEvents[1])
+
+	var received []string
+
+	for len(received) == 0 || received[len(received)-1] != "done" {
+		select {
+		case name := <-clicks:
+			received = append(received, name)
+		case <-time.After(2 * time.Second):
+			tests.Failed("Should have routed the events to their handlers: %v", received)
+		}
+	}
+
+	if len(received) != 2 || received[0] != "once" {
+		tests.Failed("Should have enforced the options of the registered events: %v", received)
+	}
+	tests.Passed("Should have enforced the options of the registered events")
+}
+
+func TestDriverPageGuards(t *testing.T) {
+	server := httptest.NewServer(websocket.New(func() *gu.NApp {
+		app := gu.App("Guarded", nil)
+
+		app.View(elems.Div(trees.NewAttr("class", "login")), "/login", gu.BodyTarget)
+
+		app.View(elems.Div(trees.NewAttr("class", "admin")), "/admin", gu.BodyTarget).
+			Redirect(func(router.PushEvent) string { return "/login" })
+
+		return app
+	}))
+	defer server.Close()
+
+	res, err := http.Get(server.URL + "/admin")
+	if err != nil {
+		tests.Failed("Should have successfully requested the page: %q", err.Error())
+	}
+
+	page, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		tests.Failed("Should have successfully read the page: %q", err.Error())
+	}
+
+	if !strings.Contains(string(page), `class="login"`) || strings.Contains(string(page), `class="admin"`) {
+		tests.Failed("Should have rendered the route the page was redirected to")
+	}
+	tests.Passed("Should have rendered the route the page was redirected to")
+}
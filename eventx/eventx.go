@@ -637,6 +637,13 @@ type StorageEvent struct {
 	StorageArea interface{}
 }
 
+// SubmitEvent defines a struct to contain the values of a submit event fired
+// from a form, along with the values of the fields of the form by their name.
+type SubmitEvent struct {
+	Core   interface{} `json:"-"`
+	Values map[string][]string
+}
+
 // TimeEvent defines a struct to contain the values of a promiximity
 // event fired from a giving DOM.
 type TimeEvent struct {
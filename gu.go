@@ -4,9 +4,11 @@ package gu
 import (
 	"fmt"
 	"html/template"
+	"strings"
 	"sync"
 	"sync/atomic"
 
+	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/router"
 	"github.com/gu-io/gu/trees"
 )
@@ -41,6 +43,29 @@ type Location interface {
 
 	// Navigate the Driver to the provided path.
 	Navigate(router.PushDirectiveEvent)
+
+	// Replace the current entry of the history with the provided path.
+	Replace(router.PushDirectiveEvent)
+
+	// Back moves the Driver to the previous entry of the history, returning
+	// false if there is none.
+	Back() bool
+
+	// Forward moves the Driver to the next entry of the history, returning
+	// false if there is none.
+	Forward() bool
+
+	// History returns the entries of the history, oldest first.
+	History() []HistoryEntry
+}
+
+// HistoryEntry defines an entry of the navigation history of a Location, with
+// the scroll position and state saved for it.
+type HistoryEntry struct {
+	Event   router.PushEvent
+	ScrollX int
+	ScrollY int
+	State   interface{}
 }
 
 // NoopLocation defines a basic struct which implements the Location interface
@@ -60,12 +85,51 @@ func NewNoopLocation(app *NApp) *NoopLocation {
 // Navigate sets the giving app location and also sets the location of the
 // NOOPLocation which returns that always.
 func (n *NoopLocation) Navigate(pe router.PushDirectiveEvent) {
-	if newLocation, err := router.NewPushEvent(pe.To, true); err == nil {
+	if newLocation, err := n.event(pe); err == nil {
 		n.app.ActivateRoute(newLocation)
 		n.current = &newLocation
 	}
 }
 
+// Replace sets the location like Navigate, as the NoopLocation keeps no
+// history.
+func (n *NoopLocation) Replace(pe router.PushDirectiveEvent) {
+	n.Navigate(pe)
+}
+
+// Back returns false, as the NoopLocation keeps no history.
+func (n *NoopLocation) Back() bool {
+	return false
+}
+
+// Forward returns false, as the NoopLocation keeps no history.
+func (n *NoopLocation) Forward() bool {
+	return false
+}
+
+// History returns the current location as the only entry.
+func (n *NoopLocation) History() []HistoryEntry {
+	return []HistoryEntry{{Event: n.Location()}}
+}
+
+// event returns the PushEvent for the path of the directive, which is routed
+// by its hash.
+func (n *NoopLocation) event(pe router.PushDirectiveEvent) (router.PushEvent, error) {
+	return router.NewPushEvent(pe.To, true)
+}
+
+// eventLocation defines a Location which decides whether the path of a
+// directive is routed by its hash or by its path.
+type eventLocation interface {
+	event(router.PushDirectiveEvent) (router.PushEvent, error)
+}
+
+// directiveEvent returns the PushEvent for the path of the directive, which
+// is routed by its hash if it has a fragment and by its path otherwise.
+func directiveEvent(pe router.PushDirectiveEvent) (router.PushEvent, error) {
+	return router.NewPushEvent(pe.To, strings.Contains(pe.To, "#"))
+}
+
 // Location returns the current route. It stores all set routes and returns the
 // last route else returning a
 func (n *NoopLocation) Location() router.PushEvent {
@@ -100,6 +164,16 @@ type ViewUpdate struct {
 	View *NView
 }
 
+// NavigationCancelled defines a struct which is used to notify that a
+// navigation of a App was cancelled by one of its guards.
+//@notification:event
+type NavigationCancelled struct {
+	App    *NApp
+	From   router.PushEvent
+	To     router.PushEvent
+	Reason string
+}
+
 //================================================================================
 
 // Services defines a struct which exposes certain fields to be accessible to
@@ -113,6 +187,8 @@ type Services struct {
 	Unmounted Subscriptions
 	Router    *router.Router
 	ViewRoute router.Resolver
+
+	Notifications *notifications.Notifications
 }
 
 //================================================================================
@@ -122,6 +198,37 @@ type Renderable interface {
 	Render() *trees.Markup
 }
 
+// Mounter defines an interface for Renderables which are notified when their
+// view is mounted.
+type Mounter interface {
+	Mount()
+}
+
+// Unmounter defines an interface for Renderables which are notified when their
+// view is unmounted.
+type Unmounter interface {
+	Unmount()
+}
+
+// Updater defines an interface for Renderables which are notified when their
+// view is updated.
+type Updater interface {
+	Update()
+}
+
+// ShouldUpdater defines an interface for Renderables which decide if they need
+// to be rendered again when their view is rendered. Returning false reuses the
+// markup of the last render.
+type ShouldUpdater interface {
+	ShouldUpdate() bool
+}
+
+// ServicesRegisterer defines an interface for Renderables which receive the
+// Services of their view when added into it.
+type ServicesRegisterer interface {
+	RegisterServices(Services)
+}
+
 // Renderables defines a lists of Renderable structures.
 type Renderables []Renderable
 
@@ -155,9 +262,10 @@ type Reactive interface {
 // RenderCommand defines a struct to hold a giving command for the rendering
 // of a App or View using the JSON format.
 type RenderCommand struct {
-	Command string   `json:"Command"`
-	App     AppJSON  `json:"App,omitempty"`
-	View    ViewJSON `json:"View,omitempty"`
+	Command string        `json:"Command"`
+	App     AppJSON       `json:"App,omitempty"`
+	View    ViewJSON      `json:"View,omitempty"`
+	Patch   ViewPatchJSON `json:"Patch,omitempty"`
 }
 
 // AppRenderCommand returns a new RenderCommand for rendering a app.
@@ -168,6 +276,36 @@ func AppRenderCommand(app *NApp, route interface{}) RenderCommand {
 	}
 }
 
+// HydrateAppCommand returns a new RenderCommand for hydrating the markup of the
+// last render of a app, which the client adopts instead of replacing. It falls
+// back to a RenderApp command if the app has not being rendered.
+func HydrateAppCommand(app *NApp) RenderCommand {
+	if !app.HasRendered() {
+		return AppRenderCommand(app, nil)
+	}
+
+	return RenderCommand{
+		Command: "HydrateApp",
+		App:     app.HydrateJSON(),
+	}
+}
+
+// HydrationMismatch defines a struct which describes a difference the client
+// found between the DOM and the markup of the app it was hydrating.
+type HydrationMismatch struct {
+	Selector string `json:"Selector"`
+	Expected string `json:"Expected"`
+	Found    string `json:"Found"`
+}
+
+// HydrationReport defines a struct which holds the mismatches reported by the
+// client after hydrating a app, where the differing markup was replaced with
+// the one expected.
+type HydrationReport struct {
+	AppID      string              `json:"AppID"`
+	Mismatches []HydrationMismatch `json:"Mismatches"`
+}
+
 // ViewRenderCommand returns a new RenderCommand for rendering a view.
 func ViewRenderCommand(view *NView) RenderCommand {
 	return RenderCommand{
@@ -176,6 +314,20 @@ func ViewRenderCommand(view *NView) RenderCommand {
 	}
 }
 
+// ViewPatchCommand returns a new RenderCommand for patching a view with the
+// changes since its last render. If the view has not being rendered before then
+// a RenderView command is returned instead.
+func ViewPatchCommand(view *NView) RenderCommand {
+	if !view.HasRendered() {
+		return ViewRenderCommand(view)
+	}
+
+	return RenderCommand{
+		Command: "PatchView",
+		Patch:   view.RenderPatch(),
+	}
+}
+
 //==============================================================================
 
 // NewReactive returns an instance of a Reactive struct.
@@ -0,0 +1,300 @@
+package gu_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/headless"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestNavigationGuards(t *testing.T) {
+	app := gu.App("Guards", nil)
+
+	var signedIn, unsaved bool
+	var pending func(router.Decision)
+	var cancelled []gu.NavigationCancelled
+
+	app.Notifications().Notify(gu.NewNavigationCancelledHandler(func(nc gu.NavigationCancelled) {
+		cancelled = append(cancelled, nc)
+	}))
+
+	app.View(elems.Div(trees.NewAttr("class", "login")), "/login", gu.BodyTarget)
+
+	app.View(elems.Div(trees.NewAttr("class", "account")), "/account/:id", gu.BodyTarget).
+		Redirect(func(pe router.PushEvent) string {
+			if !signedIn {
+				return "/login"
+			}
+
+			return ""
+		})
+
+	app.View(elems.Div(trees.NewAttr("class", "editor")), "/editor", gu.BodyTarget).
+		BeforeLeave(router.GuardFunc(func(pe router.PushEvent) router.Decision {
+			if unsaved {
+				return router.Cancel("unsaved changes")
+			}
+
+			return router.Allow()
+		}))
+
+	app.View(elems.Div(trees.NewAttr("class", "reports")), "/reports", gu.BodyTarget).
+		BeforeEnter(func(pe router.PushEvent, done func(router.Decision)) {
+			pending = done
+		})
+
+	driver, err := headless.New(app, "/login")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	driver.Navigate("/account/12")
+	if driver.Query(".login") == nil || driver.Query(".account") != nil {
+		tests.Failed("Should have redirected to the login view")
+	}
+	tests.Passed("Should have redirected to the login view")
+
+	signedIn = true
+	driver.Navigate("/account/12")
+	if driver.Query(".account") == nil {
+		tests.Failed("Should have entered the account view")
+	}
+	tests.Passed("Should have entered the account view")
+
+	driver.Navigate("/editor")
+	unsaved = true
+	driver.Navigate("/login")
+
+	if driver.Query(".editor") == nil || len(cancelled) != 1 || cancelled[0].Reason != "unsaved changes" {
+		tests.Failed("Should have cancelled leaving the editor view: %#v", cancelled)
+	}
+	tests.Passed("Should have cancelled leaving the editor view")
+
+	if cancelled[0].To.Path != "/login" || cancelled[0].App != app {
+		tests.Failed("Should have notified the cancelled route: %q", cancelled[0].To.Path)
+	}
+	tests.Passed("Should have notified the cancelled route")
+
+	unsaved = false
+	driver.Navigate("/reports")
+	if driver.Query(".editor") == nil || pending == nil {
+		tests.Failed("Should have waited for the decision of the async guard")
+	}
+	tests.Passed("Should have waited for the decision of the async guard")
+
+	pending(router.Allow())
+	if driver.Query(".reports") == nil || driver.Query(".editor") != nil {
+		tests.Failed("Should have entered the view once the async guard allowed it")
+	}
+	tests.Passed("Should have entered the view once the async guard allowed it")
+
+	driver.Navigate("/login")
+	driver.Navigate("/reports")
+	stale := pending
+
+	driver.Navigate("/account/12")
+	stale(router.Allow())
+
+	if driver.Query(".account") == nil || driver.Query(".reports") != nil {
+		tests.Failed("Should have ignored the decision of a replaced navigation")
+	}
+	tests.Passed("Should have ignored the decision of a replaced navigation")
+}
+
+func TestRedirectLoop(t *testing.T) {
+	app := gu.App("Redirects", nil)
+
+	var cancelled []gu.NavigationCancelled
+	app.Notifications().Notify(gu.NewNavigationCancelledHandler(func(nc gu.NavigationCancelled) {
+		cancelled = append(cancelled, nc)
+	}))
+
+	app.View(elems.Div(), "/a", gu.BodyTarget).Redirect(func(router.PushEvent) string { return "/b" })
+	app.View(elems.Div(), "/b", gu.BodyTarget).Redirect(func(router.PushEvent) string { return "/a" })
+
+	app.Navigate(router.PushDirectiveEvent{To: "/a"})
+
+	if len(cancelled) != 1 || cancelled[0].Reason != "too many redirects" {
+		tests.Failed("Should have cancelled the redirect loop: %#v", cancelled)
+	}
+	tests.Passed("Should have cancelled the redirect loop")
+}
+
+func TestGuardsOnParamChange(t *testing.T) {
+	app := gu.App("Orgs", nil)
+
+	var entered []string
+
+	app.View(elems.Div(trees.NewAttr("class", "org")), "/orgs/:org", gu.BodyTarget).
+		BeforeEnter(router.GuardFunc(func(pe router.PushEvent) router.Decision {
+			entered = append(entered, pe.Params["org"]+"?"+pe.Query.Encode())
+
+			if pe.Params["org"] == "secret" {
+				return router.Cancel("private org")
+			}
+
+			return router.Allow()
+		}))
+
+	driver, err := headless.New(app, "/orgs/public")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	driver.Navigate("/orgs/secret")
+	if app.Location().Path != "/orgs/public" {
+		tests.Failed("Should have cancelled entering the view with other params: %q", app.Location().Path)
+	}
+	tests.Passed("Should have cancelled entering the view with other params")
+
+	driver.Navigate("/orgs/public?tab=members")
+	driver.Navigate("/orgs/public?tab=members")
+
+	expected := []string{"public?", "secret?", "public?tab=members"}
+	if len(entered) != len(expected) {
+		tests.Failed("Should have run the guards only when params or query changed: %q", entered)
+	}
+
+	for index, entry := range expected {
+		if entered[index] != entry {
+			tests.Failed("Should have run the guards only when params or query changed: %q", entered)
+		}
+	}
+	tests.Passed("Should have run the guards only when params or query changed")
+}
+
+func TestInitialLocationGuards(t *testing.T) {
+	app := gu.App("Initial", nil)
+
+	app.View(elems.Div(trees.NewAttr("class", "login")), "/login", gu.BodyTarget)
+
+	app.View(elems.Div(trees.NewAttr("class", "admin")), "/admin", gu.BodyTarget).
+		Redirect(func(router.PushEvent) string { return "/login" })
+
+	driver, err := headless.New(app, "/admin")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	if driver.Query(".login") == nil || driver.Query(".admin") != nil {
+		tests.Failed("Should have redirected the initial location")
+	}
+	tests.Passed("Should have redirected the initial location")
+
+	if history := app.History(); len(history) != 1 || history[0].Event.Path != "/login" {
+		tests.Failed("Should have replaced the initial location with the redirect: %#v", history)
+	}
+	tests.Passed("Should have replaced the initial location with the redirect")
+
+	driver.Unmount()
+
+	closed := gu.App("Closed", nil)
+
+	var cancelled []gu.NavigationCancelled
+	closed.Notifications().Notify(gu.NewNavigationCancelledHandler(func(nc gu.NavigationCancelled) {
+		cancelled = append(cancelled, nc)
+	}))
+
+	closed.View(elems.Div(trees.NewAttr("class", "closed")), "/closed", gu.BodyTarget).
+		BeforeEnter(router.GuardFunc(func(router.PushEvent) router.Decision {
+			return router.Cancel("closed")
+		}))
+
+	driver, err = headless.New(closed, "/closed")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	if driver.Query(".closed") != nil || len(cancelled) != 1 || cancelled[0].Reason != "closed" {
+		tests.Failed("Should have cancelled the initial location: %#v", cancelled)
+	}
+	tests.Passed("Should have cancelled the initial location")
+}
+
+func TestHistoryGuards(t *testing.T) {
+	app := gu.App("History", nil)
+
+	var unsaved, locked bool
+	var cancelled []gu.NavigationCancelled
+
+	app.Notifications().Notify(gu.NewNavigationCancelledHandler(func(nc gu.NavigationCancelled) {
+		cancelled = append(cancelled, nc)
+	}))
+
+	app.View(elems.Div(trees.NewAttr("class", "home")), "/home", gu.BodyTarget)
+
+	app.View(elems.Div(trees.NewAttr("class", "editor")), "/editor", gu.BodyTarget).
+		BeforeLeave(router.GuardFunc(func(router.PushEvent) router.Decision {
+			if unsaved {
+				return router.Cancel("unsaved changes")
+			}
+
+			return router.Allow()
+		}))
+
+	app.View(elems.Div(trees.NewAttr("class", "vault")), "/vault", gu.BodyTarget).
+		BeforeEnter(router.GuardFunc(func(router.PushEvent) router.Decision {
+			if locked {
+				return router.Cancel("locked")
+			}
+
+			return router.Allow()
+		}))
+
+	driver, err := headless.New(app, "/home")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	driver.Navigate("/editor")
+	unsaved = true
+
+	if !driver.Back() || driver.Query(".editor") == nil || len(cancelled) != 1 || cancelled[0].Reason != "unsaved changes" {
+		tests.Failed("Should have cancelled moving back from the editor view: %#v", cancelled)
+	}
+	tests.Passed("Should have cancelled moving back from the editor view")
+
+	if app.Location().Path != "/editor" {
+		tests.Failed("Should have kept the history at the editor view: %q", app.Location().Path)
+	}
+	tests.Passed("Should have kept the history at the editor view")
+
+	unsaved = false
+	driver.Navigate("/vault")
+	driver.Back()
+	locked = true
+
+	if !driver.Forward() || driver.Query(".editor") == nil || len(cancelled) != 2 || cancelled[1].Reason != "locked" {
+		tests.Failed("Should have cancelled moving forward into the vault view: %#v", cancelled)
+	}
+	tests.Passed("Should have cancelled moving forward into the vault view")
+
+	locked = false
+
+	if !driver.Forward() || driver.Query(".vault") == nil || app.Location().Path != "/vault" {
+		tests.Failed("Should have moved forward once the guards allowed it")
+	}
+	tests.Passed("Should have moved forward once the guards allowed it")
+
+	if driver.Forward() {
+		tests.Failed("Should have reported no next entry of the history")
+	}
+	tests.Passed("Should have reported no next entry of the history")
+}
@@ -0,0 +1,151 @@
+// Package gutest provides helpers for testing gu apps, rendering an app at a
+// route and asserting on the markup of its views by css selectors, using the
+// structural comparisons of the trees/treetest package.
+package gutest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/treetest"
+)
+
+// Page defines the markup of an app rendered at a route, along with the
+// helpers to query and assert on it.
+type Page struct {
+	t     testing.TB
+	App   *gu.NApp
+	Route string
+	Root  *trees.Markup
+}
+
+// Render renders the app at the giving route and returns the Page holding its
+// markup. The route is a url whose path is matched against the routes of the
+// views, as done by the drivers when serving a page.
+func Render(t testing.TB, app *gu.NApp, route string) *Page {
+	t.Helper()
+
+	event, err := router.NewPushEvent(route, false)
+	if err != nil {
+		t.Fatalf("unable to create route %q: %s", route, err)
+	}
+
+	return &Page{
+		t:     t,
+		App:   app,
+		Route: route,
+		Root:  app.Render(event),
+	}
+}
+
+// Views returns the markup of the views activated by the route, in the order
+// they were added into the app.
+func (p *Page) Views() []*trees.Markup {
+	var views []*trees.Markup
+
+	for _, view := range p.App.ActiveViews() {
+		if markup := findUID(p.Root, view.UUID()); markup != nil {
+			views = append(views, markup)
+		}
+	}
+
+	return views
+}
+
+// Query returns the first element of the page matching the css selector.
+func (p *Page) Query(sel string) *trees.Markup {
+	return trees.Query.Query(p.Root, sel)
+}
+
+// QueryAll returns the elements of the page matching the css selector.
+func (p *Page) QueryAll(sel string) []*trees.Markup {
+	return trees.Query.QueryAll(p.Root, sel)
+}
+
+// Select returns the trees.Selection of the elements of the page matching the
+// css selector.
+func (p *Page) Select(sel string) *trees.Selection {
+	return trees.Select(p.Root, sel)
+}
+
+// Text returns the text of the elements of the page matching the css
+// selector separated by spaces, with its whitespace collapsed.
+func (p *Page) Text(sel string) string {
+	var texts []string
+
+	for _, item := range p.QueryAll(sel) {
+		texts = append(texts, treetest.Text(item))
+	}
+
+	return strings.Join(texts, " ")
+}
+
+// AssertCount fails the test if the total elements of the page matching the
+// css selector is not the giving count.
+func (p *Page) AssertCount(sel string, count int) {
+	p.t.Helper()
+
+	if found := len(p.QueryAll(sel)); found != count {
+		p.t.Fatalf("expected %d elements matching %q at %q, found %d", count, sel, p.Route, found)
+	}
+}
+
+// AssertText fails the test if the text of the elements of the page matching
+// the css selector is not the giving text, ignoring differences in whitespace.
+func (p *Page) AssertText(sel string, text string) {
+	p.t.Helper()
+
+	p.assertFound(sel)
+
+	want := treetest.Text(trees.NewText(text))
+	if got := p.Text(sel); got != want {
+		p.t.Fatalf("expected text of %q at %q to be %q, found %q", sel, p.Route, want, got)
+	}
+}
+
+// AssertHTML fails the test if the first element of the page matching the
+// css selector is not structurally equal to the markup of the giving html.
+func (p *Page) AssertHTML(sel string, html string) {
+	p.t.Helper()
+
+	treetest.AssertHTML(p.t, html, p.assertFound(sel))
+}
+
+// Snapshot compares the markup of the views of the page against the golden
+// file testdata/<name>.golden using treetest.Snapshot.
+func (p *Page) Snapshot(name string) {
+	p.t.Helper()
+
+	treetest.Snapshot(p.t, name, p.Views()...)
+}
+
+// assertFound returns the first element of the page matching the css
+// selector, failing the test if none does.
+func (p *Page) assertFound(sel string) *trees.Markup {
+	p.t.Helper()
+
+	found := p.Query(sel)
+	if found == nil {
+		p.t.Fatalf("expected an element matching %q at %q", sel, p.Route)
+	}
+
+	return found
+}
+
+// findUID returns the markup within the root with the giving uid.
+func findUID(root *trees.Markup, uid string) *trees.Markup {
+	if root.UID() == uid {
+		return root
+	}
+
+	for _, child := range root.Children() {
+		if found := findUID(child, uid); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
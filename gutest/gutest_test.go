@@ -0,0 +1,92 @@
+package gutest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/gutest"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+// recorder records the failures of assertions which are expected to fail.
+type recorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+func newApp() *gu.NApp {
+	app := gu.App("Shop", nil)
+
+	app.View(elems.Header(
+		trees.NewAttr("class", "site"),
+		elems.Header1(elems.Text("Shop")),
+	), "*", gu.BodyTarget)
+
+	app.View(elems.UnorderedList(
+		trees.NewAttr("class", "products"),
+		elems.ListItem(elems.Text("Tea")),
+		elems.ListItem(elems.Text("Coffee")),
+	), "/products", gu.BodyTarget)
+
+	return app
+}
+
+func TestPage(t *testing.T) {
+	page := gutest.Render(t, newApp(), "/products")
+
+	if views := page.Views(); len(views) != 2 {
+		tests.Failed("Should have found the markup of both views: %d", len(views))
+	}
+	tests.Passed("Should have found the markup of both views")
+
+	page.AssertCount("ul.products > li", 2)
+	page.AssertText("header.site h1", "Shop")
+	page.AssertText(".products li", "Tea Coffee")
+	page.AssertHTML("ul.products", `
+		<ul class="products">
+			<li>Tea</li>
+			<li>Coffee</li>
+		</ul>
+	`)
+	tests.Passed("Should have passed the assertions on the page")
+
+	page.Snapshot("products")
+	tests.Passed("Should have matched the snapshot of the views")
+
+	home := gutest.Render(t, newApp(), "/")
+	home.AssertCount("ul.products", 0)
+	tests.Passed("Should have rendered only the views of the route")
+}
+
+func TestPageFailures(t *testing.T) {
+	var rec recorder
+	page := gutest.Render(&rec, newApp(), "/products")
+
+	page.AssertCount("li", 3)
+	if !strings.Contains(rec.failure, "expected 3 elements") {
+		tests.Failed("Should have failed the count assertion: %q", rec.failure)
+	}
+	tests.Passed("Should have failed the count assertion")
+
+	page.AssertText("li", "Tea")
+	if !strings.Contains(rec.failure, `found "Tea Coffee"`) {
+		tests.Failed("Should have failed the text assertion: %q", rec.failure)
+	}
+	tests.Passed("Should have failed the text assertion")
+
+	page.AssertHTML("ul", `<ul class="products"><li>Tea</li></ul>`)
+	if !strings.Contains(rec.failure, "mismatch at ul") {
+		tests.Failed("Should have failed the html assertion: %q", rec.failure)
+	}
+	tests.Passed("Should have failed the html assertion")
+}
@@ -0,0 +1,45 @@
+package gu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestHydrateAppCommand(t *testing.T) {
+	app := gu.App("Hydrate", nil)
+	view := app.View(elems.Div(elems.Span(elems.Text("Hydrate"))), "*", gu.BodyTarget)
+
+	app.ActivateRoute("/")
+
+	if command := gu.HydrateAppCommand(app); command.Command != "RenderApp" {
+		tests.Failed("Should have fallen back to RenderApp before rendering: %q", command.Command)
+	}
+	tests.Passed("Should have fallen back to RenderApp before rendering")
+
+	page := app.Render(nil).HTML()
+
+	command := gu.HydrateAppCommand(app)
+	if command.Command != "HydrateApp" || command.App.AppID != app.UUID() {
+		tests.Failed("Should have produced a HydrateApp command for the app: %#v", command)
+	}
+	tests.Passed("Should have produced a HydrateApp command for the app")
+
+	if len(command.App.Body) != 1 || command.App.Body[0].ViewID != view.UUID() {
+		tests.Failed("Should have hydrated the rendered view: %#v", command.App.Body)
+	}
+	tests.Passed("Should have hydrated the rendered view")
+
+	if !strings.Contains(page, command.App.Body[0].Tree.Markup) {
+		tests.Failed("Should have hydrated the markup of the rendered page")
+	}
+	tests.Passed("Should have hydrated the markup of the rendered page")
+
+	if !strings.Contains(page, `gu-app-id="`+app.UUID()+`"`) {
+		tests.Failed("Should have marked the rendered page with the app id")
+	}
+	tests.Passed("Should have marked the rendered page with the app id")
+}
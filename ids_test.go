@@ -0,0 +1,76 @@
+package gu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+// contentAppID defines the uuid given by trees.ContentIDs to apps titled
+// "Stable", which is the same in every run.
+const contentAppID = "12e5c5d16577"
+
+type greeter struct{}
+
+func (greeter) Render() *trees.Markup {
+	return elems.Div(
+		elems.Header1(elems.Text("Hello")),
+		elems.Paragraph(elems.Text("Stable renders")),
+	)
+}
+
+func stableApp(gen trees.IDGenerator) *gu.NApp {
+	app := gu.App("Stable", nil)
+	if gen != nil {
+		app.UseIDs(gen)
+	}
+
+	view := app.View(greeter{}, "*", gu.BodyTarget)
+	view.Component(elems.Span(elems.Text("Component")), gu.AnyOrder, "*", "")
+
+	return app
+}
+
+func TestAppIDGenerators(t *testing.T) {
+	if stableApp(nil).Render("/").HTML() == stableApp(nil).Render("/").HTML() {
+		tests.Failed("Should have rendered different html with random ids")
+	}
+	tests.Passed("Should have rendered different html with random ids")
+
+	generators := map[string]func() trees.IDGenerator{
+		"content":    func() trees.IDGenerator { return trees.ContentIDs },
+		"sequential": func() trees.IDGenerator { return trees.NewSequentialIDs("gu") },
+	}
+
+	for name, gen := range generators {
+		app := stableApp(gen())
+
+		first := app.Render("/").HTML()
+		if second := app.Render("/").HTML(); first != second {
+			tests.Failed("Should have rendered the same html across renders with %s ids: %q != %q", name, first, second)
+		}
+		tests.Passed("Should have rendered the same html across renders with %s ids", name)
+
+		if other := stableApp(gen()).Render("/").HTML(); first != other {
+			tests.Failed("Should have rendered the same html across apps with %s ids: %q != %q", name, first, other)
+		}
+		tests.Passed("Should have rendered the same html across apps with %s ids", name)
+
+		// Keys from gu.NewKey end with a base key which is specific to the run.
+		base := strings.SplitN(gu.NewKey(), "-", 2)[1]
+		if strings.Contains(first, base) {
+			tests.Failed("Should have rendered no keys specific to the run with %s ids: %q", name, first)
+		}
+		tests.Passed("Should have rendered no keys specific to the run with %s ids", name)
+	}
+
+	first := stableApp(trees.ContentIDs).Render("/").HTML()
+	if !strings.Contains(first, `gu-app-id="`+contentAppID+`"`) {
+		tests.Failed("Should have rendered the same app id across runs: %q", first)
+	}
+	tests.Passed("Should have rendered the same app id across runs")
+}
@@ -0,0 +1,144 @@
+package gu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+type lifecycle struct {
+	services gu.Services
+	mounts   int
+	unmounts int
+	updates  int
+	renders  int
+	changed  bool
+}
+
+func (l *lifecycle) RegisterServices(services gu.Services) {
+	l.services = services
+}
+
+func (l *lifecycle) Mount() {
+	l.mounts++
+}
+
+func (l *lifecycle) Unmount() {
+	l.unmounts++
+}
+
+func (l *lifecycle) Update() {
+	l.updates++
+}
+
+func (l *lifecycle) ShouldUpdate() bool {
+	return l.changed
+}
+
+func (l *lifecycle) Render() *trees.Markup {
+	l.renders++
+	return elems.Span(elems.Text("Lifecycle"))
+}
+
+func TestComponentLifecycle(t *testing.T) {
+	app := gu.App("Lifecycle", nil)
+	app.UseScheduler(gu.ManualScheduler)
+
+	component := new(lifecycle)
+
+	view := app.View(elems.Div(), "*", gu.BodyTarget)
+	view.Component(component, gu.AnyOrder, "*", "")
+
+	if component.services.AppUUID != app.UUID() || component.services.Notifications != app.Notifications() {
+		tests.Failed("Should have registered the view's services with the component")
+	}
+	tests.Passed("Should have registered the view's services with the component")
+
+	app.Render("/")
+	app.Mounted()
+
+	if component.mounts != 1 {
+		tests.Failed("Should have notified the component of being mounted: %d", component.mounts)
+	}
+	tests.Passed("Should have notified the component of being mounted")
+
+	view.Publish()
+	app.Flush()
+
+	if component.updates != 1 {
+		tests.Failed("Should have notified the component of being updated: %d", component.updates)
+	}
+	tests.Passed("Should have notified the component of being updated")
+
+	first := view.Render().Children()
+	second := view.Render().Children()
+
+	if component.renders != 1 {
+		tests.Failed("Should have skipped rendering the unchanged component: %d", component.renders)
+	}
+	tests.Passed("Should have skipped rendering the unchanged component")
+
+	if len(first) != 1 || len(second) != 1 || first[0] == second[0] || first[0].HTML() != second[0].HTML() {
+		tests.Failed("Should have reused a copy of the last render of the component: %d %d", len(first), len(second))
+	}
+	tests.Passed("Should have reused a copy of the last render of the component")
+
+	component.changed = true
+	view.Render()
+
+	if component.renders != 2 {
+		tests.Failed("Should have rendered the changed component: %d", component.renders)
+	}
+	tests.Passed("Should have rendered the changed component")
+
+	app.Unmounted()
+
+	if component.unmounts != 1 {
+		tests.Failed("Should have notified the component of being unmounted: %d", component.unmounts)
+	}
+	tests.Passed("Should have notified the component of being unmounted")
+}
+
+func TestStaticMorphComponent(t *testing.T) {
+	app := gu.App("Morph", nil)
+
+	admin := router.NewRouting("/admin", &trees.HideMorpher{})
+
+	static := gu.Static(elems.Div(elems.Span(elems.Text("Admin"), admin)))
+	static.Morph = true
+
+	view := app.View(elems.Div(), "*", gu.BodyTarget)
+	view.Component(static, gu.AnyOrder, "*", "")
+
+	render := func(path string) string {
+		event, err := router.NewPushEvent(path, false)
+		if err != nil {
+			tests.Failed("Should have created the route %q: %s", path, err)
+		}
+
+		admin.Resolve(event)
+		return trees.Query.Query(view.Render(), "span").HTML()
+	}
+
+	if !strings.Contains(render("/"), "display:none") {
+		tests.Failed("Should have hidden the content outside of its route")
+	}
+	tests.Passed("Should have hidden the content outside of its route")
+
+	if !strings.Contains(render("/admin"), "display:block") {
+		tests.Failed("Should have morphed the content again on a route change")
+	}
+	tests.Passed("Should have morphed the content again on a route change")
+
+	static.Content = elems.Div(elems.Span(elems.Text("Changed")))
+
+	if !strings.Contains(render("/admin"), "Changed") {
+		tests.Failed("Should have rendered the changed content of the static view")
+	}
+	tests.Passed("Should have rendered the changed content of the static view")
+}
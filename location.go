@@ -0,0 +1,185 @@
+package gu
+
+import (
+	"sync"
+
+	"github.com/gu-io/gu/router"
+)
+
+// DefaultHistorySize defines the total entries kept by a MemoryLocation when
+// not given a size.
+const DefaultHistorySize = 50
+
+// MemoryLocation defines a Location which keeps the history of an app in
+// memory like the browser does, for drivers without one like servers and
+// tests. The history is bounded, dropping its oldest entries once full, and
+// navigating from an earlier entry drops the entries after it.
+type MemoryLocation struct {
+	app  *NApp
+	size int
+
+	ml      sync.Mutex
+	entries []HistoryEntry
+	index   int
+}
+
+// NewMemoryLocation returns a new instance of a MemoryLocation for the app
+// keeping at most the giving total of entries, or DefaultHistorySize if not
+// above zero.
+func NewMemoryLocation(app *NApp, size int) *MemoryLocation {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+
+	return &MemoryLocation{
+		app:   app,
+		size:  size,
+		index: -1,
+	}
+}
+
+// Navigate adds the path as a new entry of the history after the current
+// one and activates its route in the app, or replaces the current entry if
+// the directive sets Replace.
+func (m *MemoryLocation) Navigate(pe router.PushDirectiveEvent) {
+	if pe.Replace {
+		m.Replace(pe)
+		return
+	}
+
+	event, err := m.event(pe)
+	if err != nil {
+		return
+	}
+
+	m.ml.Lock()
+	m.entries = append(m.entries[:m.index+1], HistoryEntry{Event: event})
+	if len(m.entries) > m.size {
+		m.entries = m.entries[len(m.entries)-m.size:]
+	}
+
+	m.index = len(m.entries) - 1
+	m.ml.Unlock()
+
+	m.app.ActivateRoute(event)
+}
+
+// Replace replaces the current entry of the history with the path, dropping
+// its saved scroll position and state, and activates its route in the app.
+func (m *MemoryLocation) Replace(pe router.PushDirectiveEvent) {
+	event, err := m.event(pe)
+	if err != nil {
+		return
+	}
+
+	m.ml.Lock()
+	if m.index == -1 {
+		m.entries = append(m.entries, HistoryEntry{Event: event})
+		m.index = 0
+	} else {
+		m.entries[m.index] = HistoryEntry{Event: event}
+	}
+	m.ml.Unlock()
+
+	m.app.ActivateRoute(event)
+}
+
+// Back moves to the previous entry of the history and activates its route in
+// the app, returning false if there is none.
+func (m *MemoryLocation) Back() bool {
+	return m.move(-1)
+}
+
+// Forward moves to the next entry of the history and activates its route in
+// the app, returning false if there is none.
+func (m *MemoryLocation) Forward() bool {
+	return m.move(1)
+}
+
+// move moves the current entry of the history by the giving step.
+func (m *MemoryLocation) move(step int) bool {
+	m.ml.Lock()
+	index := m.index + step
+	if index < 0 || index >= len(m.entries) {
+		m.ml.Unlock()
+		return false
+	}
+
+	m.index = index
+	event := m.entries[index].Event
+	m.ml.Unlock()
+
+	m.app.ActivateRoute(event)
+	return true
+}
+
+// event returns the PushEvent for the path of the directive, which is routed
+// by its hash if it has a fragment and by its path otherwise.
+func (m *MemoryLocation) event(pe router.PushDirectiveEvent) (router.PushEvent, error) {
+	return directiveEvent(pe)
+}
+
+// Location returns the route of the current entry of the history, or the
+// root route if the history is empty.
+func (m *MemoryLocation) Location() router.PushEvent {
+	if entry, ok := m.Current(); ok {
+		return entry.Event
+	}
+
+	root, _ := router.NewPushEvent("/#", true)
+	return root
+}
+
+// Current returns the current entry of the history, if any.
+func (m *MemoryLocation) Current() (HistoryEntry, bool) {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+
+	if m.index == -1 {
+		return HistoryEntry{}, false
+	}
+
+	return m.entries[m.index], true
+}
+
+// Index returns the position of the current entry in the history, which is
+// -1 when the history is empty.
+func (m *MemoryLocation) Index() int {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+
+	return m.index
+}
+
+// History returns a copy of the entries of the history, oldest first.
+func (m *MemoryLocation) History() []HistoryEntry {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+
+	entries := make([]HistoryEntry, len(m.entries))
+	copy(entries, m.entries)
+
+	return entries
+}
+
+// SaveScroll saves the scroll position of the current entry of the history,
+// to be restored when the entry is returned to.
+func (m *MemoryLocation) SaveScroll(x int, y int) {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+
+	if m.index != -1 {
+		m.entries[m.index].ScrollX = x
+		m.entries[m.index].ScrollY = y
+	}
+}
+
+// SaveState saves the giving state with the current entry of the history.
+func (m *MemoryLocation) SaveState(state interface{}) {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+
+	if m.index != -1 {
+		m.entries[m.index].State = state
+	}
+}
@@ -0,0 +1,126 @@
+package gu_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/headless"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestMemoryLocation(t *testing.T) {
+	app := gu.App("History", nil)
+	location := gu.NewMemoryLocation(app, 3)
+
+	for _, path := range []string{"/a", "/b", "/c", "/d"} {
+		location.Navigate(router.PushDirectiveEvent{To: path})
+	}
+
+	history := location.History()
+	if len(history) != 3 || history[0].Event.Path != "/b" || location.Location().Path != "/d" {
+		tests.Failed("Should have dropped the oldest entry of a full history: %d", len(history))
+	}
+	tests.Passed("Should have dropped the oldest entry of a full history")
+
+	location.SaveScroll(0, 120)
+	location.SaveState("draft")
+
+	if !location.Back() || location.Location().Path != "/c" || location.Index() != 1 {
+		tests.Failed("Should have moved back to the previous entry: %q", location.Location().Path)
+	}
+	tests.Passed("Should have moved back to the previous entry")
+
+	if !location.Forward() {
+		tests.Failed("Should have moved forward to the next entry")
+	}
+	tests.Passed("Should have moved forward to the next entry")
+
+	if entry, ok := location.Current(); !ok || entry.ScrollY != 120 || entry.State != "draft" {
+		tests.Failed("Should have kept the scroll and state of the entry: %#v", entry)
+	}
+	tests.Passed("Should have kept the scroll and state of the entry")
+
+	if location.Forward() {
+		tests.Failed("Should not have moved past the last entry")
+	}
+	tests.Passed("Should not have moved past the last entry")
+
+	location.Back()
+	location.Navigate(router.PushDirectiveEvent{To: "/e"})
+
+	history = location.History()
+	if len(history) != 3 || history[2].Event.Path != "/e" || location.Forward() {
+		tests.Failed("Should have dropped the entries after the current one: %d", len(history))
+	}
+	tests.Passed("Should have dropped the entries after the current one")
+
+	location.Navigate(router.PushDirectiveEvent{To: "/f", Replace: true})
+
+	history = location.History()
+	if len(history) != 3 || history[2].Event.Path != "/f" {
+		tests.Failed("Should have replaced the current entry: %d", len(history))
+	}
+	tests.Passed("Should have replaced the current entry")
+}
+
+func TestAppHistory(t *testing.T) {
+	app := gu.App("History", nil)
+
+	app.View(elems.Div(trees.NewAttr("class", "home")), "/home", gu.BodyTarget)
+	app.View(elems.Div(trees.NewAttr("class", "about")), "/about", gu.BodyTarget)
+	app.View(elems.Div(trees.NewAttr("class", "login")), "/login", gu.BodyTarget)
+
+	driver, err := headless.New(app, "/home")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	driver.Navigate("/about")
+	app.Replace(router.PushDirectiveEvent{To: "/login"})
+
+	history := app.History()
+	if len(history) != 2 || history[0].Event.Path != "/home" || history[1].Event.Path != "/login" {
+		tests.Failed("Should have recorded the navigations of the app: %d", len(history))
+	}
+	tests.Passed("Should have recorded the navigations of the app")
+
+	if !driver.Back() || driver.Query(".home") == nil || driver.Query(".login") != nil {
+		tests.Failed("Should have rendered the previous entry")
+	}
+	tests.Passed("Should have rendered the previous entry")
+
+	if !driver.Forward() || driver.Query(".login") == nil {
+		tests.Failed("Should have rendered the next entry")
+	}
+	tests.Passed("Should have rendered the next entry")
+
+	if driver.Forward() {
+		tests.Failed("Should not have moved past the last entry")
+	}
+	tests.Passed("Should not have moved past the last entry")
+}
+
+func TestNoopLocationHashRouting(t *testing.T) {
+	app := gu.App("Noop", nil)
+	app.InitApp(gu.NewNoopLocation(app))
+
+	about := app.View(elems.Div(), "/about", gu.BodyTarget)
+
+	app.Navigate(router.PushDirectiveEvent{To: "/index.html#/about"})
+	if app.Location().Rem != "/about" || len(app.ActiveViews()) != 1 || app.ActiveViews()[0] != about {
+		tests.Failed("Should have routed the path by its hash: %q", app.Location().Rem)
+	}
+	tests.Passed("Should have routed the path by its hash")
+
+	app.Navigate(router.PushDirectiveEvent{To: "/about"})
+	if app.Location().Rem != "/#" || len(app.ActiveViews()) != 0 {
+		tests.Failed("Should have routed a path without a fragment by its hash: %q", app.Location().Rem)
+	}
+	tests.Passed("Should have routed a path without a fragment by its hash")
+}
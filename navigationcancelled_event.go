@@ -0,0 +1,252 @@
+package gu
+
+import (
+	"sync"
+
+	"github.com/gu-io/gu/common"
+)
+
+// NavigationCancelledSubscriber defines a interface that which is used to subscribe specifically for
+// events  NavigationCancelled type.
+type NavigationCancelledSubscriber interface {
+	Receive(NavigationCancelled)
+}
+
+//=========================================================================================================
+
+// NavigationCancelledHandler defines a structure type which implements the
+// NavigationCancelledSubscriber interface and the EventDistributor interface.
+type NavigationCancelledHandler struct {
+	handle func(NavigationCancelled)
+}
+
+// NewNavigationCancelledHandler returns a new instance of a NavigationCancelledHandler.
+func NewNavigationCancelledHandler(fn func(NavigationCancelled)) *NavigationCancelledHandler {
+	return &NavigationCancelledHandler{
+		handle: fn,
+	}
+}
+
+// Receive takes the giving value and execute it against the underline handler.
+func (sn *NavigationCancelledHandler) Receive(elem NavigationCancelled) {
+	sn.handle(elem)
+}
+
+// Handle takes the giving value and asserts the expected value to match the
+// NavigationCancelled type then passes it to the Receive method.
+func (sn *NavigationCancelledHandler) Handle(receive interface{}) {
+	if elem, ok := receive.(NavigationCancelled); ok {
+		sn.Receive(elem)
+	}
+}
+
+//=========================================================================================================
+
+// NavigationCancelledNotification defines a structure type which must be used to
+// receive NavigationCancelled type has a event.
+type NavigationCancelledNotification struct {
+	sml        sync.Mutex
+	subs       []*NavigationCancelledSubscription
+	validation func(NavigationCancelled) bool
+}
+
+// NewNavigationCancelledNotificationWith returns a new instance of NavigationCancelledNotification.
+// Events which fail the validation function are not delivered to any subscriber.
+func NewNavigationCancelledNotificationWith(validation func(NavigationCancelled) bool) *NavigationCancelledNotification {
+	var elem NavigationCancelledNotification
+	elem.validation = validation
+
+	return &elem
+}
+
+// NewNavigationCancelledNotification returns a new instance of NewNavigationCancelledNotification.
+func NewNavigationCancelledNotification() *NavigationCancelledNotification {
+	var elem NavigationCancelledNotification
+	return &elem
+}
+
+// UnNotify removes the given subscriber from the notification's list if found from future events.
+func (sn *NavigationCancelledNotification) UnNotify(sub NavigationCancelledSubscriber) {
+	sn.do(func() {
+		var subs []*NavigationCancelledSubscription
+
+		for _, subscription := range sn.subs {
+			if subscription.sub == sub {
+				subscription.done = true
+				continue
+			}
+
+			subs = append(subs, subscription)
+		}
+
+		sn.subs = subs
+	})
+}
+
+// Notify adds the given subscriber into the notification list and will await an update of
+// a new event of the given NavigationCancelled type. The returned common.Remover removes the subscription.
+func (sn *NavigationCancelledNotification) Notify(sub NavigationCancelledSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, false, 0)
+}
+
+// NotifyPriority adds the given subscriber into the notification list with the giving
+// priority. Subscribers with a higher priority receive events first, while those with
+// the same priority receive them in the order they subscribed. Notify uses a priority of 0.
+func (sn *NavigationCancelledNotification) NotifyPriority(priority int, sub NavigationCancelledSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, false, priority)
+}
+
+// NotifyOnce adds the given subscriber into the notification list for only the next
+// event of the given NavigationCancelled type, after which it is removed.
+func (sn *NavigationCancelledNotification) NotifyOnce(sub NavigationCancelledSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, true, 0)
+}
+
+// NotifyWhen adds the given subscriber into the notification list to receive only the
+// events of the given NavigationCancelled type which match the predicate.
+func (sn *NavigationCancelledNotification) NotifyWhen(predicate func(NavigationCancelled) bool, sub NavigationCancelledSubscriber) common.Remover {
+	return sn.subscribe(sub, predicate, false, 0)
+}
+
+// Handle takes the giving value and asserts the expected value to be of
+// the type and pass on to it's underline subscribers else ignoring the event.
+// Events which fail the validation of the notification are ignored.
+func (sn *NavigationCancelledNotification) Handle(elem interface{}) {
+	elemEvent, ok := elem.(NavigationCancelled)
+	if !ok {
+		return
+	}
+
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return
+	}
+
+	// The list is replaced on every change, which allows subscribers to be
+	// added and removed while the event is delivered.
+	var subs []*NavigationCancelledSubscription
+	sn.do(func() {
+		subs = sn.subs
+	})
+
+	for _, subscription := range subs {
+		if subscription.predicate != nil && !subscription.predicate(elemEvent) {
+			continue
+		}
+
+		if !sn.claim(subscription) {
+			continue
+		}
+
+		subscription.sub.Receive(elemEvent)
+	}
+}
+
+// subscribe adds a new subscription of the giving subscriber into the notification list,
+// after the subscriptions with the same or a higher priority.
+func (sn *NavigationCancelledNotification) subscribe(sub NavigationCancelledSubscriber, predicate func(NavigationCancelled) bool, once bool, priority int) *NavigationCancelledSubscription {
+	subscription := &NavigationCancelledSubscription{
+		sn:        sn,
+		sub:       sub,
+		once:      once,
+		priority:  priority,
+		predicate: predicate,
+	}
+
+	sn.do(func() {
+		index := len(sn.subs)
+		for index > 0 && sn.subs[index-1].priority < priority {
+			index--
+		}
+
+		subs := make([]*NavigationCancelledSubscription, 0, len(sn.subs)+1)
+		subs = append(subs, sn.subs[:index]...)
+		subs = append(subs, subscription)
+		sn.subs = append(subs, sn.subs[index:]...)
+	})
+
+	return subscription
+}
+
+// claim returns true/false if the giving subscription is still to receive the
+// event, removing it when it is a once-only subscription.
+func (sn *NavigationCancelledNotification) claim(subscription *NavigationCancelledSubscription) bool {
+	var claimed bool
+
+	sn.do(func() {
+		if subscription.done {
+			return
+		}
+
+		claimed = true
+
+		if subscription.once {
+			sn.remove(subscription)
+		}
+	})
+
+	return claimed
+}
+
+// remove removes the giving subscription from the notification list, it must be
+// called with the mutex locked.
+func (sn *NavigationCancelledNotification) remove(subscription *NavigationCancelledSubscription) {
+	subscription.done = true
+
+	var subs []*NavigationCancelledSubscription
+	for _, item := range sn.subs {
+		if item != subscription {
+			subs = append(subs, item)
+		}
+	}
+
+	sn.subs = subs
+}
+
+// do performs action with the mutex locked and unlocked appropriately, ensuring safe
+// concurrent access.
+func (sn *NavigationCancelledNotification) do(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	sn.sml.Lock()
+	defer sn.sml.Unlock()
+
+	fn()
+}
+
+//=========================================================================================================
+
+// NavigationCancelledSubscription defines a single subscription of a NavigationCancelledSubscriber into a
+// NavigationCancelledNotification, which implements the common.Remover interface.
+type NavigationCancelledSubscription struct {
+	sn        *NavigationCancelledNotification
+	sub       NavigationCancelledSubscriber
+	once      bool
+	done      bool
+	priority  int
+	predicate func(NavigationCancelled) bool
+	removals  []func()
+}
+
+// Add adds the giving function to be called when the subscription is removed.
+func (s *NavigationCancelledSubscription) Add(fn func()) {
+	s.sn.do(func() {
+		s.removals = append(s.removals, fn)
+	})
+}
+
+// Remove removes the subscription from its notification list, calling the
+// functions added to it.
+func (s *NavigationCancelledSubscription) Remove() {
+	var removals []func()
+
+	s.sn.do(func() {
+		s.sn.remove(s)
+		removals, s.removals = s.removals, nil
+	})
+
+	for _, fn := range removals {
+		fn()
+	}
+}
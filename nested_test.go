@@ -0,0 +1,148 @@
+package gu_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/headless"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestNestedViews(t *testing.T) {
+	app := gu.App("Nested", nil)
+
+	layout := new(lifecycle)
+	settings := new(lifecycle)
+
+	users := app.View(elems.Div(trees.NewAttr("class", "users"), elems.Outlet()), "/users/:id/*", gu.BodyTarget)
+	users.Component(layout, gu.AnyOrder, "*", "")
+
+	users.NamedView("user.posts", elems.Div(trees.NewAttr("class", "posts")), "/posts")
+	users.View(settings, "/settings")
+	users.View(elems.Div(trees.NewAttr("class", "overview")), "*")
+
+	var id string
+	settings.services.ViewRoute.Done(func(pe router.PushEvent) {
+		id = pe.Params["id"]
+	})
+
+	if url, err := app.URLFor("user.posts", router.Params{"id": "2"}); err != nil || url != "/users/2/posts" {
+		tests.Failed("Should have built the url of the nested view: %q %v", url, err)
+	}
+	tests.Passed("Should have built the url of the nested view")
+
+	driver, err := headless.New(app, "/users/1/posts")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	if driver.Query(".users [gu-outlet] .posts") == nil || driver.Query(".overview") != nil {
+		tests.Failed("Should have rendered the nested view into the outlet")
+	}
+	tests.Passed("Should have rendered the nested view into the outlet")
+
+	driver.Navigate("/users/7/settings")
+
+	if driver.Query(".posts") != nil || driver.Query(".users [gu-outlet] span") == nil {
+		tests.Failed("Should have switched the nested view")
+	}
+	tests.Passed("Should have switched the nested view")
+
+	if id != "7" {
+		tests.Failed("Should have passed the parameters down to the nested view: %q", id)
+	}
+	tests.Passed("Should have passed the parameters down to the nested view")
+
+	if settings.mounts != 1 || layout.mounts != 1 || layout.unmounts != 0 {
+		tests.Failed("Should have only mounted the changed part of the chain: %d %d %d", settings.mounts, layout.mounts, layout.unmounts)
+	}
+	tests.Passed("Should have only mounted the changed part of the chain")
+
+	driver.Navigate("/users/7")
+
+	if driver.Query(".overview") == nil || settings.unmounts != 1 {
+		tests.Failed("Should have unmounted the nested view for the fallback: %d", settings.unmounts)
+	}
+	tests.Passed("Should have unmounted the nested view for the fallback")
+}
+
+func TestNestedGuards(t *testing.T) {
+	app := gu.App("Nested", nil)
+
+	var reason string
+	app.Notifications().Notify(gu.NewNavigationCancelledHandler(func(cancelled gu.NavigationCancelled) {
+		reason = cancelled.Reason
+	}))
+
+	users := app.View(elems.Div(trees.NewAttr("class", "users"), elems.Outlet()), "/users/:id/*", gu.BodyTarget)
+
+	var saved bool
+	users.View(elems.Div(trees.NewAttr("class", "editor")), "/edit").
+		BeforeLeave(router.GuardFunc(func(pe router.PushEvent) router.Decision {
+			if !saved {
+				return router.Cancel("unsaved changes")
+			}
+
+			return router.Allow()
+		}))
+
+	users.View(elems.Div(trees.NewAttr("class", "admin")), "/admin").
+		BeforeEnter(router.GuardFunc(func(pe router.PushEvent) router.Decision {
+			if pe.Params["id"] != "1" {
+				return router.Cancel("not an admin")
+			}
+
+			return router.Allow()
+		}))
+
+	driver, err := headless.New(app, "/users/2/edit")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	driver.Navigate("/users/1/admin")
+
+	if reason != "unsaved changes" || driver.Query(".editor") == nil {
+		tests.Failed("Should have run the leave guard of the nested view: %q", reason)
+	}
+	tests.Passed("Should have run the leave guard of the nested view")
+
+	saved = true
+	driver.Navigate("/users/2/admin")
+
+	if reason != "not an admin" || driver.Query(".editor") == nil {
+		tests.Failed("Should have run the enter guard with the parameters of the parent: %q", reason)
+	}
+	tests.Passed("Should have run the enter guard with the parameters of the parent")
+
+	driver.Navigate("/users/1/admin")
+
+	if driver.Query(".admin") == nil || driver.Query(".editor") != nil {
+		tests.Failed("Should have navigated to the allowed nested view")
+	}
+	tests.Passed("Should have navigated to the allowed nested view")
+}
+
+func TestNestedViewRoute(t *testing.T) {
+	app := gu.App("Nested", nil)
+
+	view := app.View(elems.Div(), "/users/:id", gu.BodyTarget)
+
+	defer func() {
+		if recover() == nil {
+			tests.Failed("Should have panicked for a route without a remainder")
+		}
+		tests.Passed("Should have panicked for a route without a remainder")
+	}()
+
+	view.View(elems.Div(), "/posts")
+}
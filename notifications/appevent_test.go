@@ -0,0 +1,93 @@
+package notifications_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/notifications"
+	"github.com/influx6/faux/tests"
+)
+
+func TestAppEventNotification(t *testing.T) {
+	notification := notifications.NewAppEventNotificationWith(func(ev notifications.AppEvent) bool {
+		return ev.UUID == "app"
+	})
+
+	var all, once, matched int
+
+	remover := notification.Notify(notifications.NewAppEventHandler(func(notifications.AppEvent) {
+		all++
+	}))
+
+	notification.NotifyOnce(notifications.NewAppEventHandler(func(notifications.AppEvent) {
+		once++
+	}))
+
+	notification.NotifyWhen(func(ev notifications.AppEvent) bool {
+		return ev.Event == "match"
+	}, notifications.NewAppEventHandler(func(notifications.AppEvent) {
+		matched++
+	}))
+
+	notification.Deliver("app", "match")
+	notification.Deliver("app", "other")
+	notification.Deliver("another-app", "match")
+
+	if all != 2 {
+		tests.Failed("Should have delivered only validated events: %d", all)
+	}
+	tests.Passed("Should have delivered only validated events")
+
+	if once != 1 {
+		tests.Failed("Should have delivered a single event to once subscriber: %d", once)
+	}
+	tests.Passed("Should have delivered a single event to once subscriber")
+
+	if matched != 1 {
+		tests.Failed("Should have delivered only matching events to predicate subscriber: %d", matched)
+	}
+	tests.Passed("Should have delivered only matching events to predicate subscriber")
+
+	remover.Remove()
+	notification.Deliver("app", "other")
+
+	if all != 2 {
+		tests.Failed("Should not have delivered events after removal: %d", all)
+	}
+	tests.Passed("Should not have delivered events after removal")
+}
+
+func TestAppEventNotificationPriority(t *testing.T) {
+	notification := notifications.NewAppEventNotification()
+
+	var order []string
+
+	notification.Notify(notifications.NewAppEventHandler(func(notifications.AppEvent) {
+		order = append(order, "normal")
+	}))
+
+	notification.NotifyPriority(10, notifications.NewAppEventHandler(func(notifications.AppEvent) {
+		order = append(order, "high")
+	}))
+
+	notification.NotifyPriority(-10, notifications.NewAppEventHandler(func(notifications.AppEvent) {
+		order = append(order, "low")
+	}))
+
+	notification.NotifyPriority(10, notifications.NewAppEventHandler(func(notifications.AppEvent) {
+		order = append(order, "high-later")
+	}))
+
+	notification.Deliver("app", "event")
+
+	expected := []string{"high", "high-later", "normal", "low"}
+	if len(order) != len(expected) {
+		tests.Failed("Should have delivered to all subscribers: %+q", order)
+	}
+
+	for index, name := range expected {
+		if order[index] != name {
+			tests.Failed("Should have delivered by priority then subscription order: %+q", order)
+		}
+	}
+	tests.Passed("Should have delivered by priority then subscription order")
+}
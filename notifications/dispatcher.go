@@ -6,27 +6,28 @@ import (
 	"github.com/gu-io/gu/common"
 )
 
-// dispatch provides a default dispatcher for listening to events.
+// dispatch provides a default dispatcher for listening to events which is
+// used by the package level functions.
 var dispatch = New()
 
-// Unsubscribe adds a new listener to the dispatcher.
+// Default returns the default dispatcher used by the package level functions.
+func Default() *Notifications {
+	return dispatch
+}
+
+// Unsubscribe removes a listener from the default dispatcher.
 func Unsubscribe(dist EventDistributor) {
 	dispatch.UnNotify(dist)
 }
 
-// Subscribe adds a new listener to the dispatcher.
+// Subscribe adds a new listener to the default dispatcher.
 func Subscribe(dist EventDistributor) {
 	dispatch.Notify(dist)
 }
 
-// SubscribeWithRemover adds a new listener to the dispatcher and returns a common.Remover .
+// SubscribeWithRemover adds a new listener to the default dispatcher and returns a common.Remover .
 func SubscribeWithRemover(dist EventDistributor) common.Remover {
-	dispatch.Notify(dist)
-
-	return listenerRemover{
-		root:    dispatch,
-		handler: dist,
-	}
+	return dispatch.NotifyWithRemover(dist)
 }
 
 // ListenerRemover defines a struct which implements the common.Remover interface.
@@ -52,7 +53,7 @@ func (l listenerRemover) Remove() {
 	l.fn = nil
 }
 
-// Dispatch emits a event into the dispatch callback listeners.
+// Dispatch emits a event into the default dispatcher's listeners.
 func Dispatch(q interface{}) {
 	dispatch.Handle(q)
 }
@@ -63,12 +64,49 @@ type EventDistributor interface {
 	Handle(interface{})
 }
 
+// DeliveryDistributor defines a EventDistributor which is handed, with every
+// item, a Delivery scoped to the delivery of the item. It should be used by
+// EventDistributor which dispatch from within their handling, as items
+// dispatched through the Delivery never block on a full buffer.
+type DeliveryDistributor interface {
+	EventDistributor
+	HandleDelivery(item interface{}, delivery Delivery)
+}
+
+// Delivery defines a dispatcher scoped to the delivery of a item by a
+// Notifications. Items dispatched through it are queued beyond the buffer of
+// a queued Notifications with BlockOnOverflow, instead of blocking the worker
+// delivering the item.
+type Delivery struct {
+	root *Notifications
+}
+
+// Handle publishes the giving item like Notifications.Handle.
+func (d Delivery) Handle(item interface{}) {
+	d.Dispatch(item)
+}
+
+// Dispatch publishes the giving item like Notifications.Dispatch, without
+// blocking on a full buffer.
+func (d Delivery) Dispatch(item interface{}) error {
+	if d.root.queue != nil {
+		return d.root.queue.push(item, false)
+	}
+
+	d.root.deliver(item)
+	return nil
+}
+
 // Notifications defines a central delivery pipe where all types of event notifications
 // will pass through to be delivered to all EventDistributor listening.
+//
+// By default events are delivered synchronously by the caller of Handle, see
+// NewQueued for a dispatcher which delivers them from worker goroutines.
 type Notifications struct {
 	ml       sync.Mutex
 	sources  []EventDistributor
 	register map[EventDistributor]int
+	queue    *queue
 }
 
 // New returns a new instance of a Notification primitive.
@@ -86,30 +124,95 @@ func (n *Notifications) UnNotify(source EventDistributor) {
 			return
 		}
 
+		delete(n.register, source)
+
 		n.sources[index] = nil
 		n.sources = append(n.sources[:index], n.sources[index+1:]...)
+
+		// Shift the index of the sources after the removed one.
+		for _, item := range n.sources[index:] {
+			n.register[item]--
+		}
 	})
 }
 
 // Notify adds a giving EventDistributor into the notifications list.
 func (n *Notifications) Notify(source EventDistributor) {
 	n.do(func() {
+		if _, ok := n.register[source]; ok {
+			return
+		}
+
 		n.register[source] = len(n.sources)
 		n.sources = append(n.sources, source)
 	})
 }
 
+// NotifyWithRemover adds a giving EventDistributor into the notifications list
+// and returns a common.Remover which removes it.
+func (n *Notifications) NotifyWithRemover(source EventDistributor) common.Remover {
+	n.Notify(source)
+
+	return listenerRemover{
+		root:    n,
+		handler: source,
+	}
+}
+
 // Handle will publish giving type to all internal EventDistributor who are
 // expected to convert the needed interface{} into expected type for consumption
 // for their internal state or operations.
 func (n *Notifications) Handle(item interface{}) {
-	n.do(func() {
-		for _, source := range n.sources {
-			if source != nil {
-				source.Handle(item)
-			}
+	n.Dispatch(item)
+}
+
+// Dispatch publishes the giving item like Handle, returning ErrQueueFull or
+// ErrClosed when a queued dispatcher could not accept it.
+func (n *Notifications) Dispatch(item interface{}) error {
+	if n.queue != nil {
+		return n.queue.push(item, true)
+	}
+
+	n.deliver(item)
+	return nil
+}
+
+// Flush blocks until all queued items have been delivered. It does nothing
+// for a synchronous dispatcher and must not be called from a EventDistributor.
+func (n *Notifications) Flush() {
+	if n.queue != nil {
+		n.queue.flush()
+	}
+}
+
+// Close stops a queued dispatcher from accepting new items and blocks until
+// those already queued have been delivered. It does nothing for a synchronous
+// dispatcher and must not be called from a EventDistributor.
+func (n *Notifications) Close() {
+	if n.queue != nil {
+		n.queue.close()
+	}
+}
+
+// deliver calls all EventDistributor with the giving item, handing
+// DeliveryDistributor a Delivery for it. The lock is only
+// held to copy the list, allowing EventDistributor to dispatch, subscribe and
+// unsubscribe while handling the item.
+func (n *Notifications) deliver(item interface{}) {
+	n.ml.Lock()
+	sources := make([]EventDistributor, len(n.sources))
+	copy(sources, n.sources)
+	n.ml.Unlock()
+
+	for _, source := range sources {
+		switch source := source.(type) {
+		case nil:
+		case DeliveryDistributor:
+			source.HandleDelivery(item, Delivery{root: n})
+		default:
+			source.Handle(item)
 		}
-	})
+	}
 }
 
 // do performs the needed function call guarded by a mutex call block.
@@ -0,0 +1,254 @@
+package notifications_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/notifications"
+	"github.com/influx6/faux/tests"
+)
+
+type distributor struct {
+	handle func(interface{})
+}
+
+func (d *distributor) Handle(item interface{}) {
+	d.handle(item)
+}
+
+type deliverer struct {
+	handle func(interface{}, notifications.Delivery)
+}
+
+func (d *deliverer) Handle(item interface{}) {
+	tests.Failed("Should have been handed the delivery of item: %#v", item)
+}
+
+func (d *deliverer) HandleDelivery(item interface{}, delivery notifications.Delivery) {
+	d.handle(item, delivery)
+}
+
+func TestReentrantDispatch(t *testing.T) {
+	dispatch := notifications.New()
+
+	var received []interface{}
+	dispatch.Notify(&distributor{func(item interface{}) {
+		received = append(received, item)
+
+		if item == 1 {
+			dispatch.Handle(2)
+		}
+	}})
+
+	dispatch.Handle(1)
+
+	if len(received) != 2 || received[1] != 2 {
+		tests.Failed("Should have delivered the item dispatched from a handler: %#v", received)
+	}
+	tests.Passed("Should have delivered the item dispatched from a handler")
+}
+
+func TestUnNotify(t *testing.T) {
+	dispatch := notifications.New()
+
+	var calls [3]int
+	handlers := make([]notifications.EventDistributor, 3)
+	for index := range handlers {
+		index := index
+		handlers[index] = &distributor{func(interface{}) { calls[index]++ }}
+		dispatch.Notify(handlers[index])
+	}
+
+	dispatch.UnNotify(handlers[0])
+	dispatch.UnNotify(handlers[2])
+	dispatch.Handle(nil)
+
+	if calls != [3]int{0, 1, 0} {
+		tests.Failed("Should have only delivered to the remaining handler: %#v", calls)
+	}
+	tests.Passed("Should have only delivered to the remaining handler")
+}
+
+func TestQueuedDispatch(t *testing.T) {
+	dispatch := notifications.NewQueued(notifications.QueueConfig{Buffer: 4})
+	defer dispatch.Close()
+
+	var ml sync.Mutex
+	var received []int
+
+	dispatch.Notify(&deliverer{func(item interface{}, delivery notifications.Delivery) {
+		ml.Lock()
+		received = append(received, item.(int))
+		ml.Unlock()
+
+		// Dispatching from within a handler must not deadlock the worker.
+		if item.(int) < 10 {
+			delivery.Handle(item.(int) + 10)
+		}
+	}})
+
+	for i := 0; i < 10; i++ {
+		if err := dispatch.Dispatch(i); err != nil {
+			tests.Failed("Should have successfully dispatched item %d: %q", i, err.Error())
+		}
+	}
+	tests.Passed("Should have successfully dispatched items")
+
+	dispatch.Flush()
+
+	ml.Lock()
+	defer ml.Unlock()
+
+	if len(received) != 20 {
+		tests.Failed("Should have delivered all items after flush: %#v", received)
+	}
+	tests.Passed("Should have delivered all items after flush")
+}
+
+func TestQueuedReentrantOverflow(t *testing.T) {
+	dispatch := notifications.NewQueued(notifications.QueueConfig{
+		Buffer:   1,
+		Overflow: notifications.BlockOnOverflow,
+	})
+	defer dispatch.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var ml sync.Mutex
+	var received []int
+
+	dispatch.Notify(&deliverer{func(item interface{}, delivery notifications.Delivery) {
+		ml.Lock()
+		received = append(received, item.(int))
+		ml.Unlock()
+
+		if item.(int) != 0 {
+			return
+		}
+
+		close(started)
+		<-release
+
+		// The buffer is full, dispatching through the delivery must not block.
+		for i := 10; i < 15; i++ {
+			if err := delivery.Dispatch(i); err != nil {
+				tests.Failed("Should have queued item %d beyond the buffer: %q", i, err.Error())
+			}
+		}
+	}})
+
+	dispatch.Dispatch(0)
+	<-started
+	dispatch.Dispatch(1)
+	close(release)
+
+	done := make(chan struct{})
+	go func() {
+		dispatch.Flush()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		tests.Passed("Should have dispatched from within the handler while the buffer is full")
+	case <-time.After(time.Second):
+		tests.Failed("Should have dispatched from within the handler while the buffer is full")
+	}
+
+	ml.Lock()
+	defer ml.Unlock()
+
+	if len(received) != 7 {
+		tests.Failed("Should have delivered all items after flush: %#v", received)
+	}
+	tests.Passed("Should have delivered all items after flush")
+}
+
+func TestQueuedOverflow(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	newBlocked := func(policy notifications.OverflowPolicy, received *[]interface{}) *notifications.Notifications {
+		dispatch := notifications.NewQueued(notifications.QueueConfig{Buffer: 2, Overflow: policy})
+		dispatch.Notify(&distributor{func(item interface{}) {
+			if item == "hold" {
+				started <- struct{}{}
+				<-release
+				return
+			}
+
+			*received = append(*received, item)
+		}})
+
+		dispatch.Dispatch("hold")
+		<-started
+
+		return dispatch
+	}
+
+	var errored []interface{}
+	dispatch := newBlocked(notifications.ErrorOnOverflow, &errored)
+	dispatch.Dispatch(1)
+	dispatch.Dispatch(2)
+
+	if err := dispatch.Dispatch(3); err != notifications.ErrQueueFull {
+		tests.Failed("Should have rejected item with full queue: %#v", err)
+	}
+	tests.Passed("Should have rejected item with full queue")
+
+	release <- struct{}{}
+	dispatch.Close()
+
+	if err := dispatch.Dispatch(4); err != notifications.ErrClosed {
+		tests.Failed("Should have rejected item with closed dispatcher: %#v", err)
+	}
+	tests.Passed("Should have rejected item with closed dispatcher")
+
+	if len(errored) != 2 || errored[0] != 1 || errored[1] != 2 {
+		tests.Failed("Should have delivered queued items before closing: %#v", errored)
+	}
+	tests.Passed("Should have delivered queued items before closing")
+
+	var dropped []interface{}
+	dispatch = newBlocked(notifications.DropOldestOnOverflow, &dropped)
+	dispatch.Dispatch(1)
+	dispatch.Dispatch(2)
+	dispatch.Dispatch(3)
+
+	release <- struct{}{}
+	dispatch.Close()
+
+	if len(dropped) != 2 || dropped[0] != 2 || dropped[1] != 3 {
+		tests.Failed("Should have dropped the oldest item: %#v", dropped)
+	}
+	tests.Passed("Should have dropped the oldest item")
+
+	var blocked []interface{}
+	dispatch = newBlocked(notifications.BlockOnOverflow, &blocked)
+	dispatch.Dispatch(1)
+	dispatch.Dispatch(2)
+
+	done := make(chan struct{})
+	go func() {
+		dispatch.Dispatch(3)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		tests.Failed("Should have blocked the caller while the subscriber is busy")
+	case <-time.After(50 * time.Millisecond):
+		tests.Passed("Should have blocked the caller while the subscriber is busy")
+	}
+
+	release <- struct{}{}
+	<-done
+	dispatch.Close()
+
+	if len(blocked) != 3 || blocked[2] != 3 {
+		tests.Failed("Should have delivered the blocked item once there was room: %#v", blocked)
+	}
+	tests.Passed("Should have delivered the blocked item once there was room")
+}
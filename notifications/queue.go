@@ -0,0 +1,177 @@
+package notifications
+
+import (
+	"errors"
+	"sync"
+)
+
+// contains the errors returned by a queued Notifications.
+var (
+	ErrQueueFull = errors.New("notifications: queue is full")
+	ErrClosed    = errors.New("notifications: dispatcher is closed")
+)
+
+// OverflowPolicy defines how a queued Notifications handles items dispatched
+// when its buffer is full.
+type OverflowPolicy int
+
+const (
+	// BlockOnOverflow blocks the dispatching caller until the buffer has room.
+	// Items dispatched through the Delivery handed to a DeliveryDistributor
+	// are queued beyond the buffer instead, as blocking the worker delivering
+	// the item could leave no one to make room.
+	BlockOnOverflow OverflowPolicy = iota
+
+	// DropOldestOnOverflow drops the oldest queued item to make room.
+	DropOldestOnOverflow
+
+	// ErrorOnOverflow rejects the item with ErrQueueFull.
+	ErrorOnOverflow
+)
+
+// QueueConfig defines the configuration of a queued Notifications.
+type QueueConfig struct {
+	// Buffer sets the number of items which can be waiting for delivery,
+	// defaulting to 64.
+	Buffer int
+
+	// Workers sets the number of goroutines delivering items, defaulting to 1.
+	// Items are delivered in the order they are dispatched only with a single
+	// worker.
+	Workers int
+
+	// Overflow sets the policy applied when the buffer is full.
+	Overflow OverflowPolicy
+}
+
+// NewQueued returns a new instance of a Notification primitive which queues
+// dispatched items into a bounded buffer, to be delivered by worker goroutines.
+// This allows EventDistributor to dispatch from within their handling and
+// keeps a slow EventDistributor from blocking the dispatching caller.
+func NewQueued(config QueueConfig) *Notifications {
+	if config.Buffer <= 0 {
+		config.Buffer = 64
+	}
+
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+
+	nl := New()
+	nl.queue = newQueue(nl, config)
+
+	return nl
+}
+
+// queue defines the bounded buffer and workers of a queued Notifications.
+type queue struct {
+	root   *Notifications
+	config QueueConfig
+
+	ml       sync.Mutex
+	changed  *sync.Cond
+	items    []interface{}
+	inflight int
+	closed   bool
+	workers  sync.WaitGroup
+}
+
+// newQueue returns a new queue delivering into the giving Notifications,
+// starting its workers.
+func newQueue(root *Notifications, config QueueConfig) *queue {
+	q := &queue{root: root, config: config}
+	q.changed = sync.NewCond(&q.ml)
+
+	q.workers.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		go q.work()
+	}
+
+	return q
+}
+
+// push adds the item into the buffer, applying the overflow policy if full.
+// BlockOnOverflow only blocks the caller if block is true, otherwise the item
+// is queued beyond the buffer.
+func (q *queue) push(item interface{}, block bool) error {
+	q.ml.Lock()
+	defer q.ml.Unlock()
+
+	for !q.closed && len(q.items) >= q.config.Buffer {
+		switch q.config.Overflow {
+		case ErrorOnOverflow:
+			return ErrQueueFull
+		case DropOldestOnOverflow:
+			q.items[0] = nil
+			q.items = q.items[1:]
+			continue
+		}
+
+		if !block {
+			break
+		}
+
+		q.changed.Wait()
+	}
+
+	if q.closed {
+		return ErrClosed
+	}
+
+	q.items = append(q.items, item)
+	q.changed.Broadcast()
+
+	return nil
+}
+
+// work delivers the queued items until the queue is closed and drained.
+func (q *queue) work() {
+	defer q.workers.Done()
+
+	for {
+		q.ml.Lock()
+		for !q.closed && len(q.items) == 0 {
+			q.changed.Wait()
+		}
+
+		if len(q.items) == 0 {
+			q.ml.Unlock()
+			return
+		}
+
+		item := q.items[0]
+		q.items[0] = nil
+		q.items = q.items[1:]
+		q.inflight++
+		q.changed.Broadcast()
+		q.ml.Unlock()
+
+		q.root.deliver(item)
+
+		q.ml.Lock()
+		q.inflight--
+		q.changed.Broadcast()
+		q.ml.Unlock()
+	}
+}
+
+// flush blocks until the buffer is empty and no item is being delivered.
+func (q *queue) flush() {
+	q.ml.Lock()
+	defer q.ml.Unlock()
+
+	for len(q.items) > 0 || q.inflight > 0 {
+		q.changed.Wait()
+	}
+}
+
+// close stops the queue from accepting items and waits for the workers to
+// deliver the remaining ones.
+func (q *queue) close() {
+	q.ml.Lock()
+	q.closed = true
+	q.changed.Broadcast()
+	q.ml.Unlock()
+
+	q.workers.Wait()
+}
@@ -0,0 +1,250 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrBindTarget is returned by Bind when not given a pointer to a struct.
+var ErrBindTarget = errors.New("router: Bind requires a pointer to a struct")
+
+// BindError defines the failure to decode a parameter into a struct field.
+type BindError struct {
+	Field string
+	Param string
+	Value string
+	Err   error
+}
+
+// Error returns the message of the error.
+func (b *BindError) Error() string {
+	if b.Value == "" {
+		return fmt.Sprintf("param %q of field %s: %s", b.Param, b.Field, b.Err)
+	}
+
+	return fmt.Sprintf("param %q of field %s with value %q: %s", b.Param, b.Field, b.Value, b.Err)
+}
+
+// BindErrors defines the list of failures returned by Bind.
+type BindErrors []*BindError
+
+// Error returns the messages of the errors joined together.
+func (b BindErrors) Error() string {
+	messages := make([]string, len(b))
+	for index, err := range b {
+		messages[index] = err.Error()
+	}
+
+	return "router: unable to bind params: " + strings.Join(messages, "; ")
+}
+
+// errRequired is the failure of a required parameter which was not provided.
+var errRequired = errors.New("is required")
+
+// timeLayouts defines the layouts tried in order when decoding a time.Time.
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// Bind decodes the path parameters and query values of the PushEvent into the
+// fields of the struct pointed to by v, so views need not parse them. Every
+// exported field is filled from the parameter named by its 'param' tag or by
+// its lowercased name, where path parameters take precedence over the query.
+// A tag of "-" skips the field and a ",required" option fails the field when
+// the parameter is missing.
+//
+// Fields can be strings, bools, ints, uints, floats, time.Duration, time.Time
+// (as RFC3339 or a 2006-01-02 date), pointers to those or slices of those,
+// which are filled from every value of a query parameter. All fields which
+// fail to decode are reported together as BindErrors.
+//
+//	type Search struct {
+//		Query string    `param:"q,required"`
+//		Page  int       `param:"page"`
+//		Tags  []string  `param:"tag"`
+//		Since time.Time `param:"since"`
+//	}
+func Bind(pe PushEvent, v interface{}) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return ErrBindTarget
+	}
+
+	target = target.Elem()
+	kind := target.Type()
+
+	var failures BindErrors
+
+	for index := 0; index < kind.NumField(); index++ {
+		field := kind.Field(index)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, required := paramName(field)
+		if name == "-" {
+			continue
+		}
+
+		values := paramValues(pe, name)
+		if len(values) == 0 {
+			if required {
+				failures = append(failures, &BindError{Field: field.Name, Param: name, Err: errRequired})
+			}
+
+			continue
+		}
+
+		if err := setField(target.Field(index), values); err != nil {
+			failures = append(failures, &BindError{
+				Field: field.Name,
+				Param: name,
+				Value: strings.Join(values, ","),
+				Err:   err,
+			})
+		}
+	}
+
+	if len(failures) != 0 {
+		return failures
+	}
+
+	return nil
+}
+
+// paramName returns the name of the parameter of the field and whether it is
+// required.
+func paramName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("param")
+	if tag == "" {
+		return strings.ToLower(field.Name), false
+	}
+
+	parts := strings.Split(tag, ",")
+
+	name := parts[0]
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+
+	for _, option := range parts[1:] {
+		if option == "required" {
+			return name, true
+		}
+	}
+
+	return name, false
+}
+
+// paramValues returns the values of the parameter from the path parameters of
+// the PushEvent or else from its query.
+func paramValues(pe PushEvent, name string) []string {
+	if value, ok := pe.Params[name]; ok && value != "" {
+		return []string{value}
+	}
+
+	var values []string
+
+	for _, value := range pe.Query[name] {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// setField decodes the values into the field.
+func setField(field reflect.Value, values []string) error {
+	switch {
+	case field.Kind() == reflect.Slice:
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+
+		for index, value := range values {
+			if err := setValue(slice.Index(index), value); err != nil {
+				return err
+			}
+		}
+
+		field.Set(slice)
+		return nil
+	case field.Kind() == reflect.Ptr:
+		item := reflect.New(field.Type().Elem())
+
+		if err := setValue(item.Elem(), values[0]); err != nil {
+			return err
+		}
+
+		field.Set(item)
+		return nil
+	}
+
+	return setValue(field, values[0])
+}
+
+// setValue decodes the value into the giving field.
+func setValue(field reflect.Value, value string) error {
+	switch field.Type() {
+	case timeType:
+		for _, layout := range timeLayouts {
+			if parsed, err := time.Parse(layout, value); err == nil {
+				field.Set(reflect.ValueOf(parsed))
+				return nil
+			}
+		}
+
+		return errors.New("is not a valid time")
+	case durationType:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return errors.New("is not a valid duration")
+		}
+
+		field.SetInt(int64(parsed))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.New("is not a valid bool")
+		}
+
+		field.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("is not a valid integer")
+		}
+
+		field.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("is not a valid unsigned integer")
+		}
+
+		field.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return errors.New("is not a valid number")
+		}
+
+		field.SetFloat(parsed)
+	default:
+		return fmt.Errorf("has unsupported type %s", field.Type())
+	}
+
+	return nil
+}
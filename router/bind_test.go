@@ -0,0 +1,128 @@
+package router_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/router"
+	"github.com/influx6/faux/tests"
+)
+
+func TestPushEventQuery(t *testing.T) {
+	pe, err := router.NewPushEvent("http://localhost/search?q=gopher&tag=go&tag=web", false)
+	if err != nil {
+		tests.Failed("Should have created the PushEvent: %s", err)
+	}
+	tests.Passed("Should have created the PushEvent")
+
+	if pe.Query.Get("q") != "gopher" || len(pe.Query["tag"]) != 2 {
+		tests.Failed("Should have parsed the query of the path: %#v", pe.Query)
+	}
+	tests.Passed("Should have parsed the query of the path")
+
+	pe, err = router.NewPushEvent("http://localhost/#/search?q=gopher", true)
+	if err != nil {
+		tests.Failed("Should have created the hashed PushEvent: %s", err)
+	}
+	tests.Passed("Should have created the hashed PushEvent")
+
+	if pe.Query.Get("q") != "gopher" || pe.Hash != "/search" {
+		tests.Failed("Should have parsed the query of the hash: %q %#v", pe.Hash, pe.Query)
+	}
+	tests.Passed("Should have parsed the query of the hash")
+}
+
+func TestResolverQuery(t *testing.T) {
+	rx := router.NewResolver("/search?q&page")
+	if rx.Pattern() != "/search?q&page" {
+		tests.Failed("Should have kept the declared query in the pattern: %q", rx.Pattern())
+	}
+	tests.Passed("Should have kept the declared query in the pattern")
+
+	var params router.Params
+	rx.Done(func(pe router.PushEvent) {
+		params = pe.Params
+	})
+
+	pe, err := router.NewPushEvent("http://localhost/search?q=gopher&page=2&sort=asc", false)
+	if err != nil {
+		tests.Failed("Should have created the PushEvent: %s", err)
+	}
+	tests.Passed("Should have created the PushEvent")
+
+	rx.Resolve(pe)
+
+	if params["q"] != "gopher" || params["page"] != "2" {
+		tests.Failed("Should have added the declared query to the params: %#v", params)
+	}
+	tests.Passed("Should have added the declared query to the params")
+
+	if _, ok := params["sort"]; ok {
+		tests.Failed("Should have left out undeclared query values: %#v", params)
+	}
+	tests.Passed("Should have left out undeclared query values")
+
+	path, err := router.Build("/search?q&page", router.Params{"q": "go lang", "page": "2"})
+	if err != nil || path != "/search?page=2&q=go+lang" {
+		tests.Failed("Should have built the declared query: %q %v", path, err)
+	}
+	tests.Passed("Should have built the declared query")
+}
+
+type search struct {
+	ID      int           `param:"id,required"`
+	Query   string        `param:"q,required"`
+	Page    uint          `param:"page"`
+	Tags    []string      `param:"tag"`
+	Since   time.Time     `param:"since"`
+	Timeout time.Duration `param:"timeout"`
+	Ratio   *float64      `param:"ratio"`
+	Exact   bool
+	Skipped string `param:"-"`
+}
+
+func TestBind(t *testing.T) {
+	pe, err := router.NewPushEvent("http://localhost/items/4?q=gopher&page=3&tag=go&tag=web&since=2017-05-01&timeout=2s&ratio=0.5&exact=true&skipped=yes", false)
+	if err != nil {
+		tests.Failed("Should have created the PushEvent: %s", err)
+	}
+	tests.Passed("Should have created the PushEvent")
+
+	pe.Params["id"] = "4"
+
+	var s search
+	if err := router.Bind(pe, &s); err != nil {
+		tests.Failed("Should have bound the params: %s", err)
+	}
+	tests.Passed("Should have bound the params")
+
+	since := time.Date(2017, 5, 1, 0, 0, 0, 0, time.UTC)
+	if s.ID != 4 || s.Query != "gopher" || s.Page != 3 || len(s.Tags) != 2 || !s.Since.Equal(since) {
+		tests.Failed("Should have decoded the params and query: %#v", s)
+	}
+	tests.Passed("Should have decoded the params and query")
+
+	if s.Timeout != 2*time.Second || s.Ratio == nil || *s.Ratio != 0.5 || !s.Exact || s.Skipped != "" {
+		tests.Failed("Should have decoded durations, pointers and untagged fields: %#v", s)
+	}
+	tests.Passed("Should have decoded durations, pointers and untagged fields")
+
+	pe, _ = router.NewPushEvent("http://localhost/items?page=last", false)
+
+	err = router.Bind(pe, &s)
+	failures, ok := err.(router.BindErrors)
+	if !ok || len(failures) != 3 {
+		tests.Failed("Should have reported every failing field: %v", err)
+	}
+	tests.Passed("Should have reported every failing field")
+
+	if failures[0].Field != "ID" || failures[2].Param != "page" || failures[2].Value != "last" {
+		tests.Failed("Should have described the failing fields: %v", err)
+	}
+	tests.Passed("Should have described the failing fields")
+
+	if err := router.Bind(pe, s); err != router.ErrBindTarget {
+		tests.Failed("Should have rejected a non-pointer target: %v", err)
+	}
+	tests.Passed("Should have rejected a non-pointer target")
+}
@@ -0,0 +1,74 @@
+package router
+
+// Decision defines the outcome of a Guard for a navigation, which allows it
+// when empty, cancels it when Cancel is set or sends it to another path when
+// Redirect is set.
+type Decision struct {
+	Cancel   bool
+	Reason   string
+	Redirect string
+}
+
+// Allowed returns true/false if the decision allows the navigation.
+func (d Decision) Allowed() bool {
+	return !d.Cancel && d.Redirect == ""
+}
+
+// Allow returns a Decision which allows a navigation.
+func Allow() Decision {
+	return Decision{}
+}
+
+// Cancel returns a Decision which cancels a navigation for the giving reason.
+func Cancel(reason string) Decision {
+	return Decision{Cancel: true, Reason: reason}
+}
+
+// RedirectTo returns a Decision which sends a navigation to the giving path.
+func RedirectTo(path string) Decision {
+	return Decision{Redirect: path}
+}
+
+// Guard defines a function which decides on a navigation to or from a route,
+// calling done once with its Decision. The decision can be made after the
+// guard returns, like once a confirmation or a request completes.
+type Guard func(event PushEvent, done func(Decision))
+
+// GuardFunc returns a Guard which decides on a navigation immediately with
+// the Decision returned by the giving function.
+func GuardFunc(fn func(PushEvent) Decision) Guard {
+	return func(event PushEvent, done func(Decision)) {
+		done(fn(event))
+	}
+}
+
+// RedirectGuard returns a Guard which sends a navigation to the path returned
+// by the giving function, allowing it if the path is empty.
+func RedirectGuard(fn func(PushEvent) string) Guard {
+	return GuardFunc(func(event PushEvent) Decision {
+		if path := fn(event); path != "" {
+			return RedirectTo(path)
+		}
+
+		return Allow()
+	})
+}
+
+// RunGuards runs the giving guards in order with the event, calling done with
+// the first Decision which does not allow the navigation, or with Allow once
+// all guards allowed it.
+func RunGuards(guards []Guard, event PushEvent, done func(Decision)) {
+	if len(guards) == 0 {
+		done(Allow())
+		return
+	}
+
+	guards[0](event, func(decision Decision) {
+		if !decision.Allowed() {
+			done(decision)
+			return
+		}
+
+		RunGuards(guards[1:], event, done)
+	})
+}
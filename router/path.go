@@ -20,9 +20,11 @@ func WrapHandler(fx func()) func(PushEvent) {
 }
 
 // PushDirectiveEvent defines a event which is used to declare the switching
-// of the route to another path provided.
+// of the route to another path provided. When Replace is set, the path
+// replaces the current entry of the history instead of being added to it.
 type PushDirectiveEvent struct {
-	To string
+	To      string
+	Replace bool
 }
 
 // PushEvent represent the current path and hash values.
@@ -36,16 +38,33 @@ type PushEvent struct {
 	To     string
 	From   string
 	Params map[string]string
+	Query  url.Values
 }
 
-// NewPushEvent returns PushEvent based on the path string provided.
+// NewPushEvent returns PushEvent based on the path string provided. The query
+// of the path, or of its hash when routed by the hash, is parsed into Query.
 func NewPushEvent(path string, useHash bool) (PushEvent, error) {
 	ups, err := url.Parse(path)
 	if err != nil {
 		return PushEvent{}, err
 	}
 
+	query := ups.Query()
+
 	hash := strings.TrimSpace(ups.Fragment)
+	if index := strings.Index(hash, "?"); useHash && index != -1 {
+		hashQuery, err := url.ParseQuery(hash[index+1:])
+		if err != nil {
+			return PushEvent{}, err
+		}
+
+		for key, values := range hashQuery {
+			query[key] = append(query[key], values...)
+		}
+
+		hash = hash[:index]
+	}
+
 	if hash == "" {
 		hash = "/#"
 	}
@@ -65,6 +84,7 @@ func NewPushEvent(path string, useHash bool) (PushEvent, error) {
 		Rem:    target,
 		From:   ups.String(),
 		Params: make(map[string]string),
+		Query:  query,
 	}, nil
 }
 
@@ -132,6 +152,7 @@ func ListenFor(hash bool, pattern string, fx func(PushEvent), fail func(PushEven
 
 			fx(PushEvent{
 				Params: params,
+				Query:  p.Query,
 				Rem:    rem,
 				Path:   p.Path,
 				Hash:   p.Hash,
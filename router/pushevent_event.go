@@ -1,6 +1,10 @@
 package router
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/gu-io/gu/common"
+)
 
 // PushEventSubscriber defines a interface that which is used to subscribe specifically for
 // events  PushEvent type.
@@ -42,17 +46,15 @@ func (sn *PushEventHandler) Handle(receive interface{}) {
 // receive PushEvent type has a event.
 type PushEventNotification struct {
 	sml        sync.Mutex
-	subs       []PushEventSubscriber
+	subs       []*PushEventSubscription
 	validation func(PushEvent) bool
-	register   map[PushEventSubscriber]int
 }
 
 // NewPushEventNotificationWith returns a new instance of PushEventNotification.
+// Events which fail the validation function are not delivered to any subscriber.
 func NewPushEventNotificationWith(validation func(PushEvent) bool) *PushEventNotification {
 	var elem PushEventNotification
-
 	elem.validation = validation
-	elem.register = make(map[PushEventSubscriber]int, 0)
 
 	return &elem
 }
@@ -60,52 +62,144 @@ func NewPushEventNotificationWith(validation func(PushEvent) bool) *PushEventNot
 // NewPushEventNotification returns a new instance of NewPushEventNotification.
 func NewPushEventNotification() *PushEventNotification {
 	var elem PushEventNotification
-	elem.register = make(map[PushEventSubscriber]int, 0)
-
 	return &elem
 }
 
 // UnNotify removes the given subscriber from the notification's list if found from future events.
 func (sn *PushEventNotification) UnNotify(sub PushEventSubscriber) {
 	sn.do(func() {
-		index, ok := sn.register[sub]
-		if !ok {
-			return
+		var subs []*PushEventSubscription
+
+		for _, subscription := range sn.subs {
+			if subscription.sub == sub {
+				subscription.done = true
+				continue
+			}
+
+			subs = append(subs, subscription)
 		}
 
-		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+		sn.subs = subs
 	})
 }
 
 // Notify adds the given subscriber into the notification list and will await an update of
-// a new event of the given PushEvent type.
-func (sn *PushEventNotification) Notify(sub PushEventSubscriber) {
-	sn.do(func() {
-		sn.register[sub] = len(sn.subs)
-		sn.subs = append(sn.subs, sub)
-	})
+// a new event of the given PushEvent type. The returned common.Remover removes the subscription.
+func (sn *PushEventNotification) Notify(sub PushEventSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, false, 0)
+}
+
+// NotifyPriority adds the given subscriber into the notification list with the giving
+// priority. Subscribers with a higher priority receive events first, while those with
+// the same priority receive them in the order they subscribed. Notify uses a priority of 0.
+func (sn *PushEventNotification) NotifyPriority(priority int, sub PushEventSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, false, priority)
+}
+
+// NotifyOnce adds the given subscriber into the notification list for only the next
+// event of the given PushEvent type, after which it is removed.
+func (sn *PushEventNotification) NotifyOnce(sub PushEventSubscriber) common.Remover {
+	return sn.subscribe(sub, nil, true, 0)
+}
+
+// NotifyWhen adds the given subscriber into the notification list to receive only the
+// events of the given PushEvent type which match the predicate.
+func (sn *PushEventNotification) NotifyWhen(predicate func(PushEvent) bool, sub PushEventSubscriber) common.Remover {
+	return sn.subscribe(sub, predicate, false, 0)
 }
 
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
+// Events which fail the validation of the notification are ignored.
 func (sn *PushEventNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(PushEvent); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	elemEvent, ok := elem.(PushEvent)
+	if !ok {
+		return
+	}
+
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return
+	}
+
+	// The list is replaced on every change, which allows subscribers to be
+	// added and removed while the event is delivered.
+	var subs []*PushEventSubscription
+	sn.do(func() {
+		subs = sn.subs
+	})
+
+	for _, subscription := range subs {
+		if subscription.predicate != nil && !subscription.predicate(elemEvent) {
+			continue
+		}
+
+		if !sn.claim(subscription) {
+			continue
+		}
+
+		subscription.sub.Receive(elemEvent)
+	}
+}
+
+// subscribe adds a new subscription of the giving subscriber into the notification list,
+// after the subscriptions with the same or a higher priority.
+func (sn *PushEventNotification) subscribe(sub PushEventSubscriber, predicate func(PushEvent) bool, once bool, priority int) *PushEventSubscription {
+	subscription := &PushEventSubscription{
+		sn:        sn,
+		sub:       sub,
+		once:      once,
+		priority:  priority,
+		predicate: predicate,
+	}
 
+	sn.do(func() {
+		index := len(sn.subs)
+		for index > 0 && sn.subs[index-1].priority < priority {
+			index--
+		}
+
+		subs := make([]*PushEventSubscription, 0, len(sn.subs)+1)
+		subs = append(subs, sn.subs[:index]...)
+		subs = append(subs, subscription)
+		sn.subs = append(subs, sn.subs[index:]...)
+	})
+
+	return subscription
+}
+
+// claim returns true/false if the giving subscription is still to receive the
+// event, removing it when it is a once-only subscription.
+func (sn *PushEventNotification) claim(subscription *PushEventSubscription) bool {
+	var claimed bool
+
+	sn.do(func() {
+		if subscription.done {
 			return
 		}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+		claimed = true
+
+		if subscription.once {
+			sn.remove(subscription)
+		}
+	})
+
+	return claimed
+}
+
+// remove removes the giving subscription from the notification list, it must be
+// called with the mutex locked.
+func (sn *PushEventNotification) remove(subscription *PushEventSubscription) {
+	subscription.done = true
+
+	var subs []*PushEventSubscription
+	for _, item := range sn.subs {
+		if item != subscription {
+			subs = append(subs, item)
+		}
 	}
+
+	sn.subs = subs
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
@@ -120,3 +214,39 @@ func (sn *PushEventNotification) do(fn func()) {
 
 	fn()
 }
+
+//=========================================================================================================
+
+// PushEventSubscription defines a single subscription of a PushEventSubscriber into a
+// PushEventNotification, which implements the common.Remover interface.
+type PushEventSubscription struct {
+	sn        *PushEventNotification
+	sub       PushEventSubscriber
+	once      bool
+	done      bool
+	priority  int
+	predicate func(PushEvent) bool
+	removals  []func()
+}
+
+// Add adds the giving function to be called when the subscription is removed.
+func (s *PushEventSubscription) Add(fn func()) {
+	s.sn.do(func() {
+		s.removals = append(s.removals, fn)
+	})
+}
+
+// Remove removes the subscription from its notification list, calling the
+// functions added to it.
+func (s *PushEventSubscription) Remove() {
+	var removals []func()
+
+	s.sn.do(func() {
+		s.sn.remove(s)
+		removals, s.removals = s.removals, nil
+	})
+
+	for _, fn := range removals {
+		fn()
+	}
+}
@@ -1,6 +1,8 @@
 package router
 
 import (
+	"strings"
+
 	"github.com/gu-io/gu/trees"
 	"github.com/influx6/faux/pattern"
 )
@@ -19,12 +21,19 @@ type Resolver interface {
 	Resolvable
 
 	Flush()
+	Name() string
 	Pattern() string
 	Only(string, ...trees.SwitchMorpher) ResolveMorpher
 	Register(Resolver)
 	Done(Handler) Resolver
 	Failed(Handler) Resolver
 	Test(string) (map[string]string, string, bool)
+
+	BeforeEnter(Guard) Resolver
+	BeforeLeave(Guard) Resolver
+	Redirect(func(PushEvent) string) Resolver
+	Enter(PushEvent, func(Decision))
+	Leave(PushEvent, func(Decision))
 }
 
 // ResolveMorpher defines an interface for a Resolver which can morph a trees.Markup
@@ -35,10 +44,35 @@ type ResolveMorpher interface {
 }
 
 // NewResolver returns a new instance of a structure that matches
-// the Resolver interface.
+// the Resolver interface. The path can declare the query parameters of the
+// route after a '?', like '/search?q&page', which are added to the Params of
+// the PushEvent of matched routes.
 func NewResolver(path string) Resolver {
+	return newResolver("", path)
+}
+
+// NewNamedResolver returns a new Resolver for the path, registering the path
+// under the giving name in names for building its urls through Names.URL. It
+// fails with ErrRouteConflict if the name is already registered in names with
+// another pattern.
+func NewNamedResolver(names *Names, name string, path string) (Resolver, error) {
+	br := newResolver(name, path)
+	if path != "" {
+		if err := names.Add(name, br.Pattern()); err != nil {
+			return nil, err
+		}
+	}
+
+	return br, nil
+}
+
+// newResolver returns a new basicResolver for the path with the giving name.
+func newResolver(name string, path string) *basicResolver {
 	var br basicResolver
+	br.name = name
+
 	if path != "" {
+		path, br.query = splitQuery(path)
 		br.matcher = URIMatcher(path)
 	}
 
@@ -47,22 +81,38 @@ func NewResolver(path string) Resolver {
 
 // basicResolver defines a struct that implements
 type basicResolver struct {
+	name     string
 	children []Resolver
 	fails    []Handler
 	subs     []Handler
+	enters   []Guard
+	leaves   []Guard
+	query    []string
 	matcher  pattern.URIMatcher
 }
 
-// Flush resets the subscriptions and children lists to empty.
+// Flush resets the subscriptions, guards and children lists to empty.
 func (b *basicResolver) Flush() {
 	b.subs = nil
 	b.fails = nil
+	b.enters = nil
+	b.leaves = nil
 	b.children = nil
 }
 
-// Pattern returns the giving path pattern used by this resolver.
+// Name returns the name of the route of the resolver, if any.
+func (b *basicResolver) Name() string {
+	return b.name
+}
+
+// Pattern returns the giving path pattern used by this resolver, along with
+// the query parameters it declares.
 func (b *basicResolver) Pattern() string {
-	return b.matcher.Pattern()
+	if len(b.query) == 0 {
+		return b.matcher.Pattern()
+	}
+
+	return b.matcher.Pattern() + "?" + strings.Join(b.query, "&")
 }
 
 // Only returns a new instance of a ResolveMorpher which can change the rendering
@@ -116,7 +166,7 @@ func (b *basicResolver) Resolve(path PushEvent) {
 		return
 	}
 
-	params, rem, ok := b.matcher.Validate(path.Rem)
+	newPath, ok := b.match(path)
 	if !ok {
 
 		// Notify the fail subscribers.
@@ -127,30 +177,51 @@ func (b *basicResolver) Resolve(path PushEvent) {
 		return
 	}
 
+	// Notify the subscribers.
+	for _, sub := range b.subs {
+		sub(newPath)
+	}
+
+	// Notify the kids with what is left in the PushEvent.
+	for _, child := range b.children {
+		child.Resolve(newPath)
+	}
+}
+
+// match validates the remaining path of the PushEvent against the pattern of
+// this resolver, returning the PushEvent for what is left of the path with
+// the parameters matched by the pattern and the query parameters it declares.
+func (b *basicResolver) match(path PushEvent) (PushEvent, bool) {
+	params, rem, ok := b.matcher.Validate(path.Rem)
+	if !ok {
+		return PushEvent{}, false
+	}
+
 	// Copy over the parameter left from the previous path.
 	for key, val := range path.Params {
 		params[key] = val
 	}
 
-	newPath := PushEvent{
+	for _, key := range b.query {
+		if _, ok := params[key]; ok {
+			continue
+		}
+
+		if values, ok := path.Query[key]; ok && len(values) != 0 {
+			params[key] = values[0]
+		}
+	}
+
+	return PushEvent{
 		Rem:    rem,
 		Params: params,
+		Query:  path.Query,
 		Hash:   path.Hash,
 		Host:   path.Host,
 		Path:   path.Path,
 		From:   path.Rem,
 		To:     rem,
-	}
-
-	// Notify the subscribers.
-	for _, sub := range b.subs {
-		sub(newPath)
-	}
-
-	// Notify the kids with what is left in the PushEvent.
-	for _, child := range b.children {
-		child.Resolve(newPath)
-	}
+	}, true
 }
 
 // Failed adds a function to the failed subscription list for this
@@ -166,3 +237,48 @@ func (b *basicResolver) Done(sub Handler) Resolver {
 	b.subs = append(b.subs, sub)
 	return b
 }
+
+// BeforeEnter adds a Guard which decides on navigations into the route of
+// this resolver.
+func (b *basicResolver) BeforeEnter(guard Guard) Resolver {
+	b.enters = append(b.enters, guard)
+	return b
+}
+
+// BeforeLeave adds a Guard which decides on navigations away from the route
+// of this resolver.
+func (b *basicResolver) BeforeLeave(guard Guard) Resolver {
+	b.leaves = append(b.leaves, guard)
+	return b
+}
+
+// Redirect adds a Guard which sends navigations into the route of this
+// resolver to the path returned by the giving function, if not empty.
+func (b *basicResolver) Redirect(fn func(PushEvent) string) Resolver {
+	return b.BeforeEnter(RedirectGuard(fn))
+}
+
+// Enter runs the guards added through BeforeEnter for a navigation to the
+// giving PushEvent, calling done with their Decision. The guards receive the
+// PushEvent with the parameters matched by this resolver, and are skipped if
+// it does not match.
+func (b *basicResolver) Enter(path PushEvent, done func(Decision)) {
+	if b.matcher == nil {
+		RunGuards(b.enters, path, done)
+		return
+	}
+
+	matched, ok := b.match(path)
+	if !ok {
+		done(Allow())
+		return
+	}
+
+	RunGuards(b.enters, matched, done)
+}
+
+// Leave runs the guards added through BeforeLeave for a navigation to the
+// giving PushEvent, calling done with their Decision.
+func (b *basicResolver) Leave(path PushEvent, done func(Decision)) {
+	RunGuards(b.leaves, path, done)
+}
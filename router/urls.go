@@ -0,0 +1,195 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/influx6/faux/pattern"
+)
+
+// Remainder defines the key of the parameter which fills the wildcard '/*' at
+// the end of a pattern when building its url.
+const Remainder = "*"
+
+var (
+	// ErrUnknownRoute is returned when no route was registered with a name.
+	ErrUnknownRoute = errors.New("router: no route with the giving name")
+
+	// ErrMissingParam is returned when a parameter of a pattern was not
+	// provided when building its url.
+	ErrMissingParam = errors.New("router: missing route parameter")
+
+	// ErrInvalidParam is returned when the value of a parameter does not match
+	// the expression of its segment in the pattern.
+	ErrInvalidParam = errors.New("router: invalid route parameter")
+
+	// ErrRouteConflict is returned when a name is registered with a pattern
+	// other than the one already registered under it.
+	ErrRouteConflict = errors.New("router: route name registered with another pattern")
+)
+
+// RouteError defines the failure to register or build the url of a route,
+// where Err is one of ErrUnknownRoute, ErrMissingParam, ErrInvalidParam or
+// ErrRouteConflict.
+type RouteError struct {
+	Name    string
+	Pattern string
+	Param   string
+	Value   string
+	Err     error
+}
+
+// Error returns the message of the error.
+func (r *RouteError) Error() string {
+	switch r.Err {
+	case ErrUnknownRoute:
+		return fmt.Sprintf("%s: %q", r.Err, r.Name)
+	case ErrRouteConflict:
+		return fmt.Sprintf("%s: %q with %q and %q", r.Err, r.Name, r.Pattern, r.Value)
+	case ErrInvalidParam:
+		return fmt.Sprintf("%s %q in %q: %q", r.Err, r.Param, r.Pattern, r.Value)
+	}
+
+	return fmt.Sprintf("%s %q in %q", r.Err, r.Param, r.Pattern)
+}
+
+// Unwrap returns the cause of the error, for use with errors.Is.
+func (r *RouteError) Unwrap() error {
+	return r.Err
+}
+
+// Names defines a registry of route names and the patterns registered under
+// them, from which the urls of the routes are built. Each app or router keeps
+// its own Names, so the same name can be used for other routes elsewhere.
+type Names struct {
+	ml sync.RWMutex
+	m  map[string]string
+}
+
+// NewNames returns a new instance of Names.
+func NewNames() *Names {
+	return &Names{m: make(map[string]string)}
+}
+
+// Add registers the pattern under the giving name for building its url
+// through URL. Registering a name again with the same pattern does nothing,
+// while registering it with another pattern fails with ErrRouteConflict.
+func (n *Names) Add(name string, pattern string) error {
+	n.ml.Lock()
+	defer n.ml.Unlock()
+
+	if current, ok := n.m[name]; ok && current != pattern {
+		return &RouteError{Name: name, Pattern: current, Value: pattern, Err: ErrRouteConflict}
+	}
+
+	n.m[name] = pattern
+	return nil
+}
+
+// Pattern returns the pattern registered under the giving name.
+func (n *Names) Pattern(name string) (string, bool) {
+	n.ml.RLock()
+	defer n.ml.RUnlock()
+
+	pattern, ok := n.m[name]
+	return pattern, ok
+}
+
+// URL returns the path of the route registered under the giving name, with
+// the parameters of its pattern filled from params.
+func (n *Names) URL(name string, params Params) (string, error) {
+	pattern, ok := n.Pattern(name)
+	if !ok {
+		return "", &RouteError{Name: name, Err: ErrUnknownRoute}
+	}
+
+	return Build(pattern, params)
+}
+
+// Build returns the path matching the giving pattern, with its parameters
+// like ':id' or '{id:[\d+]}' filled from params and a wildcard '/*' at its
+// end filled from the Remainder parameter, if any. The query parameters
+// declared by the pattern, like '/search?q&page', are added as the query of
+// the path when found in params. An error is returned if a parameter is
+// missing or does not match the expression of its segment, as a *RouteError.
+func Build(patt string, params Params) (string, error) {
+	path, declared := splitQuery(patt)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var parts []string
+
+	for index, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		if segment == "*" && index == len(segments)-1 {
+			if rem := strings.Trim(params[Remainder], "/"); rem != "" {
+				for _, part := range strings.Split(rem, "/") {
+					parts = append(parts, url.PathEscape(part))
+				}
+			}
+
+			continue
+		}
+
+		matcher := pattern.Segment(segment)
+		if !matcher.IsParam() {
+			parts = append(parts, segment)
+			continue
+		}
+
+		value, ok := params[matcher.Segment()]
+		if !ok || value == "" {
+			return "", &RouteError{Pattern: patt, Param: matcher.Segment(), Err: ErrMissingParam}
+		}
+
+		if !matcher.Validate(value) {
+			return "", &RouteError{Pattern: patt, Param: matcher.Segment(), Value: value, Err: ErrInvalidParam}
+		}
+
+		value = url.PathEscape(value)
+		if matcher.HasHash() {
+			value = "#" + value
+		}
+
+		parts = append(parts, value)
+	}
+
+	built := "/" + strings.Join(parts, "/")
+
+	query := make(url.Values)
+	for _, key := range declared {
+		if value := params[key]; value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	if len(query) != 0 {
+		built += "?" + query.Encode()
+	}
+
+	return built, nil
+}
+
+// splitQuery returns the path of the pattern and the names of the query
+// parameters it declares after a '?'.
+func splitQuery(patt string) (string, []string) {
+	index := strings.Index(patt, "?")
+	if index == -1 {
+		return patt, nil
+	}
+
+	var names []string
+
+	for _, name := range strings.Split(patt[index+1:], "&") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return patt[:index], names
+}
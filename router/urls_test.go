@@ -0,0 +1,114 @@
+package router_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gu-io/gu/router"
+	"github.com/influx6/faux/tests"
+)
+
+func TestBuild(t *testing.T) {
+	path, err := router.Build("/users/:id/posts/{page:[\\d+]}", router.Params{"id": "ada lovelace", "page": "2"})
+	if err != nil {
+		tests.Failed("Should have built the path: %s", err)
+	}
+	tests.Passed("Should have built the path")
+
+	if path != "/users/ada%20lovelace/posts/2" {
+		tests.Failed("Should have filled and escaped the parameters: %q", path)
+	}
+	tests.Passed("Should have filled and escaped the parameters")
+
+	path, err = router.Build("/files/*", router.Params{router.Remainder: "docs/intro.md"})
+	if err != nil || path != "/files/docs/intro.md" {
+		tests.Failed("Should have filled the wildcard remainder: %q %v", path, err)
+	}
+	tests.Passed("Should have filled the wildcard remainder")
+
+	if path, err = router.Build("/files/*", nil); err != nil || path != "/files" {
+		tests.Failed("Should have left out an empty remainder: %q %v", path, err)
+	}
+	tests.Passed("Should have left out an empty remainder")
+
+	if _, err = router.Build("/users/:id", nil); !errors.Is(err, router.ErrMissingParam) {
+		tests.Failed("Should have failed for a missing parameter: %v", err)
+	}
+	tests.Passed("Should have failed for a missing parameter")
+
+	if _, err = router.Build("/posts/{page:[\\d+]}", router.Params{"page": "last"}); !errors.Is(err, router.ErrInvalidParam) {
+		tests.Failed("Should have failed for an invalid parameter: %v", err)
+	}
+	tests.Passed("Should have failed for an invalid parameter")
+}
+
+func TestNamedResolver(t *testing.T) {
+	names := router.NewNames()
+
+	rx, err := router.NewNamedResolver(names, "user.profile", "/users/:id")
+	if err != nil {
+		tests.Failed("Should have registered the named route: %s", err)
+	}
+	tests.Passed("Should have registered the named route")
+
+	if rx.Name() != "user.profile" {
+		tests.Failed("Should have named the resolver: %q", rx.Name())
+	}
+	tests.Passed("Should have named the resolver")
+
+	path, err := names.URL("user.profile", router.Params{"id": "12"})
+	if err != nil || path != "/users/12" {
+		tests.Failed("Should have built the url of the named route: %q %v", path, err)
+	}
+	tests.Passed("Should have built the url of the named route")
+
+	if params, _, ok := rx.Test(path); !ok || params["id"] != "12" {
+		tests.Failed("Should have matched the built url with the resolver: %#v", params)
+	}
+	tests.Passed("Should have matched the built url with the resolver")
+
+	if _, err := names.URL("user.unknown", nil); !errors.Is(err, router.ErrUnknownRoute) {
+		tests.Failed("Should have failed for an unknown route: %v", err)
+	}
+	tests.Passed("Should have failed for an unknown route")
+
+	if _, err := router.NewNamedResolver(names, "user.profile", "/profiles/:id"); !errors.Is(err, router.ErrRouteConflict) {
+		tests.Failed("Should have returned the conflict of the named route: %v", err)
+	}
+	tests.Passed("Should have returned the conflict of the named route")
+
+	if _, err := router.NewNamedResolver(router.NewNames(), "user.profile", "/profiles/:id"); err != nil {
+		tests.Failed("Should have kept the names of other registries apart: %s", err)
+	}
+	tests.Passed("Should have kept the names of other registries apart")
+}
+
+func TestNameRouteConflict(t *testing.T) {
+	names := router.NewNames()
+
+	if err := names.Add("post.show", "/posts/:id"); err != nil {
+		tests.Failed("Should have registered the named route: %s", err)
+	}
+	tests.Passed("Should have registered the named route")
+
+	if err := names.Add("post.show", "/posts/:id"); err != nil {
+		tests.Failed("Should have allowed registering the same pattern again: %s", err)
+	}
+	tests.Passed("Should have allowed registering the same pattern again")
+
+	err := names.Add("post.show", "/articles/:id")
+	if !errors.Is(err, router.ErrRouteConflict) {
+		tests.Failed("Should have rejected a conflicting pattern: %v", err)
+	}
+	tests.Passed("Should have rejected a conflicting pattern")
+
+	if rerr, ok := err.(*router.RouteError); !ok || rerr.Name != "post.show" || rerr.Pattern != "/posts/:id" {
+		tests.Failed("Should have reported the registered pattern: %#v", err)
+	}
+	tests.Passed("Should have reported the registered pattern")
+
+	if pattern, _ := names.Pattern("post.show"); pattern != "/posts/:id" {
+		tests.Failed("Should have kept the registered pattern: %q", pattern)
+	}
+	tests.Passed("Should have kept the registered pattern")
+}
@@ -0,0 +1,88 @@
+package gu_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/drivers/headless"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestNamedRoutes(t *testing.T) {
+	app := gu.App("Routes", nil)
+
+	app.NamedView("product", elems.Div(trees.NewAttr("class", "product")), "/products/:id", gu.BodyTarget)
+
+	app.NamedView("home", elems.Div(
+		trees.NewAttr("class", "home"),
+		app.Link("product", router.Params{"id": "42"}, elems.Text("Tea")),
+	), "/home", gu.BodyTarget)
+
+	if url, err := app.URLFor("product", router.Params{"id": "7"}); err != nil || url != "/products/7" {
+		tests.Failed("Should have built the url of the named view: %q %v", url, err)
+	}
+	tests.Passed("Should have built the url of the named view")
+
+	if _, err := app.URLFor("product", nil); err == nil {
+		tests.Failed("Should have failed to build the url without its parameters")
+	}
+	tests.Passed("Should have failed to build the url without its parameters")
+
+	driver, err := headless.New(app, "/home")
+	if err != nil {
+		tests.Failed("Should have mounted the app: %s", err)
+	}
+	tests.Passed("Should have mounted the app")
+
+	defer driver.Unmount()
+
+	if driver.Query(`a[href="/products/42"]`) == nil || driver.Query(".product") != nil {
+		tests.Failed("Should have rendered the link of the home view")
+	}
+	tests.Passed("Should have rendered the link of the home view")
+
+	if err := driver.Click("a"); err != nil {
+		tests.Failed("Should have clicked the link: %s", err)
+	}
+	tests.Passed("Should have clicked the link")
+
+	if driver.Query(".product") == nil || driver.Query(".home") != nil {
+		tests.Failed("Should have navigated to the product view")
+	}
+	tests.Passed("Should have navigated to the product view")
+}
+
+func TestNamedRoutesPerApp(t *testing.T) {
+	shop := gu.App("Shop", nil)
+	blog := gu.App("Blog", nil)
+
+	if _, err := shop.NamedView("show", elems.Div(), "/products/:id", gu.BodyTarget); err != nil {
+		tests.Failed("Should have named the route of the view: %s", err)
+	}
+	tests.Passed("Should have named the route of the view")
+
+	if _, err := blog.NamedView("show", elems.Div(), "/posts/:id", gu.BodyTarget); err != nil {
+		tests.Failed("Should have allowed another app to use the name: %s", err)
+	}
+	tests.Passed("Should have allowed another app to use the name")
+
+	if url, _ := blog.URLFor("show", router.Params{"id": "3"}); url != "/posts/3" {
+		tests.Failed("Should have built the url from the names of the app: %q", url)
+	}
+	tests.Passed("Should have built the url from the names of the app")
+
+	view, err := shop.NamedView("show", elems.Div(), "/items/:id", gu.BodyTarget)
+	if view != nil || !errors.Is(err, router.ErrRouteConflict) {
+		tests.Failed("Should have returned the conflict of the name within the app: %v", err)
+	}
+	tests.Passed("Should have returned the conflict of the name within the app")
+
+	if url, _ := shop.URLFor("show", router.Params{"id": "3"}); url != "/products/3" {
+		tests.Failed("Should have kept the route first named by the app: %q", url)
+	}
+	tests.Passed("Should have kept the route first named by the app")
+}
@@ -0,0 +1,44 @@
+package gu
+
+import "time"
+
+// DefaultFrame defines the interval used by drivers which flush the views
+// published in a NApp at the end of a frame.
+const DefaultFrame = 16 * time.Millisecond
+
+// Scheduler defines an interface for types which pick the frame boundary at
+// which the views published in a NApp are flushed as ViewUpdate notifications.
+// Schedule is called with the flush function of the app on the first publish
+// after a flush, and is expected to call it once the frame ends.
+type Scheduler interface {
+	Schedule(flush func())
+}
+
+// SchedulerFunc defines a function type which implements the Scheduler
+// interface.
+type SchedulerFunc func(flush func())
+
+// Schedule calls the function with the flush function.
+func (fn SchedulerFunc) Schedule(flush func()) {
+	fn(flush)
+}
+
+// ImmediateScheduler defines a Scheduler which flushes once a view is
+// published, delivering a ViewUpdate for every publish. It is the default
+// Scheduler of a NApp.
+var ImmediateScheduler = SchedulerFunc(func(flush func()) {
+	flush()
+})
+
+// ManualScheduler defines a Scheduler which never flushes by itself, leaving
+// the frame boundary to calls of NApp.Flush.
+var ManualScheduler = SchedulerFunc(func(flush func()) {})
+
+// FrameScheduler returns a Scheduler which flushes once the giving interval has
+// passed since the first publish of the frame. The flush happens on its own
+// goroutine.
+func FrameScheduler(interval time.Duration) Scheduler {
+	return SchedulerFunc(func(flush func()) {
+		time.AfterFunc(interval, flush)
+	})
+}
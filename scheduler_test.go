@@ -0,0 +1,143 @@
+package gu_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+func TestManualScheduler(t *testing.T) {
+	app := gu.App("Batched", nil)
+	app.UseScheduler(gu.ManualScheduler)
+
+	view := app.View(elems.Div(), "*", gu.BodyTarget)
+	other := app.View(elems.Div(), "*", gu.BodyTarget)
+
+	var updates []*gu.NView
+	app.Notifications().Notify(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		updates = append(updates, update.View)
+	}))
+
+	for i := 0; i < 5; i++ {
+		view.Publish()
+	}
+
+	other.Publish()
+
+	if len(updates) != 0 {
+		tests.Failed("Should not have delivered updates before flush: %d", len(updates))
+	}
+	tests.Passed("Should not have delivered updates before flush")
+
+	app.Flush()
+
+	if len(updates) != 2 || updates[0] != view || updates[1] != other {
+		tests.Failed("Should have delivered a single update for each published view: %d", len(updates))
+	}
+	tests.Passed("Should have delivered a single update for each published view")
+
+	app.Flush()
+
+	if len(updates) != 2 {
+		tests.Failed("Should not have delivered updates without publishes: %d", len(updates))
+	}
+	tests.Passed("Should not have delivered updates without publishes")
+}
+
+func TestFrameScheduler(t *testing.T) {
+	app := gu.App("Framed", nil)
+	app.UseScheduler(gu.FrameScheduler(10 * time.Millisecond))
+
+	view := app.View(elems.Div(), "*", gu.BodyTarget)
+
+	updates := make(chan gu.ViewUpdate, 10)
+	app.Notifications().Notify(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		updates <- update
+	}))
+
+	for i := 0; i < 5; i++ {
+		view.Publish()
+	}
+
+	select {
+	case <-updates:
+		tests.Passed("Should have delivered an update at the end of the frame")
+	case <-time.After(time.Second):
+		tests.Failed("Should have delivered an update at the end of the frame")
+	}
+
+	select {
+	case <-updates:
+		tests.Failed("Should have delivered only a single update for the frame")
+	case <-time.After(50 * time.Millisecond):
+		tests.Passed("Should have delivered only a single update for the frame")
+	}
+}
+
+func TestImmediateScheduler(t *testing.T) {
+	app := gu.App("Immediate", nil)
+	app.UseScheduler(gu.ImmediateScheduler)
+
+	view := app.View(elems.Div(), "*", gu.BodyTarget)
+
+	var updates int
+	app.Notifications().Notify(gu.NewViewUpdateHandler(func(gu.ViewUpdate) {
+		updates++
+	}))
+
+	view.Publish()
+	view.Publish()
+
+	if updates != 2 {
+		tests.Failed("Should have delivered an update for every publish: %d", updates)
+	}
+	tests.Passed("Should have delivered an update for every publish")
+}
+
+func TestDefaultScheduler(t *testing.T) {
+	app := gu.App("Default", nil)
+
+	view := app.View(elems.Div(), "*", gu.BodyTarget)
+
+	var updates int
+	app.Notifications().Notify(gu.NewViewUpdateHandler(func(gu.ViewUpdate) {
+		updates++
+	}))
+
+	view.Publish()
+
+	if updates != 1 {
+		tests.Failed("Should have delivered the update synchronously by default: %d", updates)
+	}
+	tests.Passed("Should have delivered the update synchronously by default")
+}
+
+func TestFlushDependencyOrder(t *testing.T) {
+	app := gu.App("Ordered", nil)
+	app.UseScheduler(gu.ManualScheduler)
+
+	summary := app.View(elems.Div(), "*", gu.BodyTarget)
+	cart := app.View(elems.Div(), "*", gu.BodyTarget)
+	items := cart.View(elems.Div(), "/items")
+	other := app.View(elems.Div(), "*", gu.BodyTarget)
+
+	summary.DependsOn(items)
+
+	var updates []*gu.NView
+	app.Notifications().Notify(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		updates = append(updates, update.View)
+	}))
+
+	other.Publish()
+	items.Publish()
+	summary.Publish()
+	app.Flush()
+
+	if len(updates) != 3 || updates[0] != cart || updates[1] != summary || updates[2] != other {
+		tests.Failed("Should have delivered updates after the views they depend on: %d", len(updates))
+	}
+	tests.Passed("Should have delivered updates after the views they depend on")
+}
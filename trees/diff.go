@@ -0,0 +1,333 @@
+package trees
+
+// PatchType defines the kind of change a Patch describes.
+type PatchType string
+
+// contains the different types of patches produced by Diff.
+const (
+	// SetAttributePatch sets the attribute Name to Value on the Selector element.
+	SetAttributePatch PatchType = "SetAttribute"
+
+	// RemoveAttributePatch removes the attribute Name from the Selector element.
+	RemoveAttributePatch PatchType = "RemoveAttribute"
+
+	// SetStylePatch sets the inline style Name to Value on the Selector element.
+	SetStylePatch PatchType = "SetStyle"
+
+	// RemoveStylePatch removes the inline style Name from the Selector element.
+	RemoveStylePatch PatchType = "RemoveStyle"
+
+	// ReplaceTextPatch replaces the text node at Index of the Selector element
+	// with Value, an Index of -1 replaces the text content of the element itself.
+	ReplaceTextPatch PatchType = "ReplaceText"
+
+	// InsertChildPatch inserts Markup into the Selector element before the
+	// Before element if provided, else at Index.
+	InsertChildPatch PatchType = "InsertChild"
+
+	// RemoveChildPatch removes the Target element from the Selector element if
+	// provided, else the child at Index.
+	RemoveChildPatch PatchType = "RemoveChild"
+
+	// MoveChildPatch moves the Target element of the Selector element before the
+	// Before element, appending it when Before is empty.
+	MoveChildPatch PatchType = "MoveChild"
+
+	// ReplaceNodePatch replaces the Selector element with the Markup.
+	ReplaceNodePatch PatchType = "ReplaceNode"
+)
+
+// Patch defines a single serializable operation which applies a change
+// produced by Diff to a rendered DOM.
+type Patch struct {
+	Type     PatchType `json:"Type"`
+	Selector string    `json:"Selector"`
+	Target   string    `json:"Target,omitempty"`
+	Before   string    `json:"Before,omitempty"`
+	Name     string    `json:"Name,omitempty"`
+	Value    string    `json:"Value,omitempty"`
+	Markup   string    `json:"Markup,omitempty"`
+	Index    int       `json:"Index"`
+}
+
+// Diff reconciles the new markup against the old one and returns the list of
+// patches which when applied in order on the DOM rendered from the old markup
+// turns it into the DOM of the new markup. Elements are addressed using their
+// uid, which the reconciliation swaps from the old markup into the new, so the
+// new markup should be kept to diff against the next render. Children marked
+// as removed by the reconciliation are cleaned out of the new markup.
+func Diff(old, new *Markup) []Patch {
+	if new == nil {
+		return nil
+	}
+
+	if old == nil {
+		return nil
+	}
+
+	if old.Name() != new.Name() {
+		return []Patch{{
+			Type:     ReplaceNodePatch,
+			Selector: old.EventID(),
+			Markup:   new.HTML(),
+			Index:    -1,
+		}}
+	}
+
+	new.Reconcile(old)
+
+	var patches []Patch
+	patches = diffMarkup(patches, old, new)
+
+	new.Clean()
+
+	return patches
+}
+
+// diffMarkup appends the patches needed to transform the old markup into the
+// new one, where both are expected to have the same tag name.
+func diffMarkup(patches []Patch, old, new *Markup) []Patch {
+	selector := new.EventID()
+
+	patches = diffProperties(patches, selector, old.Attributes(), new.Attributes(), SetAttributePatch, RemoveAttributePatch)
+	patches = diffProperties(patches, selector, old.Styles(), new.Styles(), SetStylePatch, RemoveStylePatch)
+
+	if text := new.TextContent(); text != old.TextContent() {
+		patches = append(patches, Patch{
+			Type:     ReplaceTextPatch,
+			Selector: selector,
+			Value:    text,
+			Index:    -1,
+		})
+	}
+
+	// the old children are taken as is, since the reconciliation marks those
+	// not carried over as removed.
+	oldChildren := old.Children()
+	newChildren := liveChildren(new)
+
+	if hasKeys(oldChildren) && hasKeys(newChildren) {
+		return diffKeyedChildren(patches, selector, oldChildren, newChildren)
+	}
+
+	return diffChildren(patches, selector, oldChildren, newChildren)
+}
+
+// diffProperties appends the set and remove patches for the properties
+// which differ between the old and new lists.
+func diffProperties(patches []Patch, selector string, old, new []Property, set, remove PatchType) []Patch {
+	oldValues := make(map[string]string, len(old))
+	for _, prop := range old {
+		name, value := prop.Render()
+		oldValues[name] = value
+	}
+
+	newValues := make(map[string]bool, len(new))
+	for _, prop := range new {
+		name, value := prop.Render()
+		if name == "NodeRemoved" {
+			continue
+		}
+
+		newValues[name] = true
+
+		if oldValue, ok := oldValues[name]; ok && oldValue == value {
+			continue
+		}
+
+		patches = append(patches, Patch{
+			Type:     set,
+			Selector: selector,
+			Name:     name,
+			Value:    value,
+			Index:    -1,
+		})
+	}
+
+	for _, prop := range old {
+		name, _ := prop.Render()
+		if name == "NodeRemoved" || newValues[name] {
+			continue
+		}
+
+		newValues[name] = true
+
+		patches = append(patches, Patch{
+			Type:     remove,
+			Selector: selector,
+			Name:     name,
+			Index:    -1,
+		})
+	}
+
+	return patches
+}
+
+// diffChildren appends the patches for children matched by their position.
+func diffChildren(patches []Patch, selector string, old, new []*Markup) []Patch {
+	for index := 0; index < len(old) && index < len(new); index++ {
+		och, nch := old[index], new[index]
+
+		// raw html, comments and doctypes can not be replaced as text, so
+		// changes to them replace the whole child.
+		opaque := och.Raw() || nch.Raw() || nch.Kind() == CommentNode || nch.Kind() == DoctypeNode
+		opaqueChanged := opaque && (och.Raw() != nch.Raw() || och.TextContent() != nch.TextContent())
+
+		if och.Name() != nch.Name() || opaqueChanged {
+			patches = append(patches, Patch{
+				Type:     RemoveChildPatch,
+				Selector: selector,
+				Index:    index,
+			}, Patch{
+				Type:     InsertChildPatch,
+				Selector: selector,
+				Markup:   nch.HTML(),
+				Index:    index,
+			})
+			continue
+		}
+
+		if nch.Kind() != ElementNode {
+			if text := nch.TextContent(); text != och.TextContent() {
+				patches = append(patches, Patch{
+					Type:     ReplaceTextPatch,
+					Selector: selector,
+					Value:    text,
+					Index:    index,
+				})
+			}
+			continue
+		}
+
+		patches = diffMarkup(patches, och, nch)
+	}
+
+	for index := len(old) - 1; index >= len(new); index-- {
+		patches = append(patches, Patch{
+			Type:     RemoveChildPatch,
+			Selector: selector,
+			Index:    index,
+		})
+	}
+
+	for index := len(old); index < len(new); index++ {
+		patches = append(patches, Patch{
+			Type:     InsertChildPatch,
+			Selector: selector,
+			Markup:   new[index].HTML(),
+			Index:    index,
+		})
+	}
+
+	return patches
+}
+
+// diffKeyedChildren appends the patches for children matched by their keys.
+// Removals are emitted first, then matched children are diffed and finally
+// inserts and moves are emitted from the last child to the first, each placed
+// before its next sibling which at that point is already in its final place.
+func diffKeyedChildren(patches []Patch, selector string, old, new []*Markup) []Patch {
+	ops := KeyedOps(old, new)
+
+	// Whitespace-only text is left in place, so moved and inserted children
+	// are placed before their next keyed sibling.
+	keyed, _ := keyedChildren(new)
+	next := make(map[*Markup]string, len(keyed))
+	for index := 0; index+1 < len(keyed); index++ {
+		next[keyed[index]] = keyed[index+1].EventID()
+	}
+
+	oldKeyed, _ := keyedChildren(old)
+
+	oldKeys := make(map[string]*Markup, len(oldKeyed))
+	for _, och := range oldKeyed {
+		oldKeys[KeyOf(och)] = och
+	}
+
+	for index := len(ops) - 1; index >= 0; index-- {
+		op := ops[index]
+		if op.Type != KeyedRemove {
+			continue
+		}
+
+		patches = append(patches, Patch{
+			Type:     RemoveChildPatch,
+			Selector: selector,
+			Target:   old[op.From].EventID(),
+			Index:    op.From,
+		})
+	}
+
+	for _, nch := range keyed {
+		och, ok := oldKeys[KeyOf(nch)]
+		if !ok {
+			continue
+		}
+
+		if och.Name() != nch.Name() {
+			patches = append(patches, Patch{
+				Type:     ReplaceNodePatch,
+				Selector: och.EventID(),
+				Markup:   nch.HTML(),
+				Index:    -1,
+			})
+			continue
+		}
+
+		patches = diffMarkup(patches, och, nch)
+	}
+
+	placed := make(map[string]KeyedOp, len(ops))
+	for _, op := range ops {
+		if op.Type != KeyedRemove {
+			placed[op.Key] = op
+		}
+	}
+
+	for index := len(new) - 1; index >= 0; index-- {
+		nch := new[index]
+
+		op, ok := placed[KeyOf(nch)]
+		if !ok {
+			continue
+		}
+
+		before := next[nch]
+
+		switch op.Type {
+		case KeyedInsert:
+			patches = append(patches, Patch{
+				Type:     InsertChildPatch,
+				Selector: selector,
+				Before:   before,
+				Markup:   nch.HTML(),
+				Index:    index,
+			})
+		case KeyedMove:
+			patches = append(patches, Patch{
+				Type:     MoveChildPatch,
+				Selector: selector,
+				Target:   nch.EventID(),
+				Before:   before,
+				Index:    index,
+			})
+		}
+	}
+
+	return patches
+}
+
+// liveChildren returns the children of the markup not marked as removed.
+func liveChildren(e *Markup) []*Markup {
+	var children []*Markup
+
+	for _, child := range e.Children() {
+		if child.Removed() {
+			continue
+		}
+
+		children = append(children, child)
+	}
+
+	return children
+}
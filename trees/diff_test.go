@@ -0,0 +1,169 @@
+package trees_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+func findPatch(patches []trees.Patch, kind trees.PatchType) (trees.Patch, bool) {
+	for _, patch := range patches {
+		if patch.Type == kind {
+			return patch, true
+		}
+	}
+
+	return trees.Patch{}, false
+}
+
+// TestDiffProperties validates the patches produced for changed attributes,
+// styles and text.
+func TestDiffProperties(t *testing.T) {
+	old := trees.NewMarkup("div", false)
+	trees.NewAttr("class", "box").Apply(old)
+	trees.NewAttr("title", "old").Apply(old)
+	trees.NewCSSStyle("width", "10px").Apply(old)
+	trees.NewText("Hello").Apply(old)
+
+	newer := trees.NewMarkup("div", false)
+	trees.NewAttr("class", "box active").Apply(newer)
+	trees.NewCSSStyle("width", "20px").Apply(newer)
+	trees.NewText("World").Apply(newer)
+
+	patches := trees.Diff(old, newer)
+
+	if newer.UID() != old.UID() {
+		t.Fatalf("\t%s\t Should have swapped the uid of the old markup into the new", failed)
+	}
+	t.Logf("\t%s\t Should have swapped the uid of the old markup into the new", success)
+
+	set, ok := findPatch(patches, trees.SetAttributePatch)
+	if !ok || set.Name != "class" || set.Value != "box active" || set.Selector != old.EventID() {
+		t.Fatalf("\t%s\t Should have produced a set attribute patch: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced a set attribute patch", success)
+
+	if remove, ok := findPatch(patches, trees.RemoveAttributePatch); !ok || remove.Name != "title" {
+		t.Fatalf("\t%s\t Should have produced a remove attribute patch: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced a remove attribute patch", success)
+
+	if style, ok := findPatch(patches, trees.SetStylePatch); !ok || style.Name != "width" || style.Value != "20px" {
+		t.Fatalf("\t%s\t Should have produced a set style patch: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced a set style patch", success)
+
+	if text, ok := findPatch(patches, trees.ReplaceTextPatch); !ok || text.Value != "World" || text.Index != 0 {
+		t.Fatalf("\t%s\t Should have produced a replace text patch: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced a replace text patch", success)
+
+	if len(patches) != 4 {
+		t.Fatalf("\t%s\t Should have produced only 4 patches: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced only 4 patches", success)
+
+	if _, err := json.Marshal(patches); err != nil {
+		t.Fatalf("\t%s\t Should have serialized patches: %+q", failed, err)
+	}
+	t.Logf("\t%s\t Should have serialized patches", success)
+}
+
+// TestDiffUnchanged validates that diffing an identical markup produces no
+// patches.
+func TestDiffUnchanged(t *testing.T) {
+	old := trees.ParseFirstOrMakeRoot(`<section class="a"><h1>Title</h1><p>Body</p></section>`)
+	newer := trees.ParseFirstOrMakeRoot(`<section class="a"><h1>Title</h1><p>Body</p></section>`)
+
+	if patches := trees.Diff(old, newer); len(patches) != 0 {
+		t.Fatalf("\t%s\t Should have produced no patches: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced no patches", success)
+}
+
+// TestDiffChildren validates the patches produced for positional children.
+func TestDiffChildren(t *testing.T) {
+	old := trees.ParseFirstOrMakeRoot(`<ul><li>One</li><li>Two</li><li>Three</li></ul>`)
+	newer := trees.ParseFirstOrMakeRoot(`<ul><li>One</li><span>Two</span></ul>`)
+
+	patches := trees.Diff(old, newer)
+
+	var removed, inserted int
+	for _, patch := range patches {
+		switch patch.Type {
+		case trees.RemoveChildPatch:
+			removed++
+		case trees.InsertChildPatch:
+			inserted++
+		}
+	}
+
+	if removed != 2 || inserted != 1 {
+		t.Fatalf("\t%s\t Should have removed two and inserted one child: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have removed two and inserted one child", success)
+
+	if len(newer.Children()) != 2 {
+		t.Fatalf("\t%s\t Should have cleaned removed children from new markup: %d", failed, len(newer.Children()))
+	}
+	t.Logf("\t%s\t Should have cleaned removed children from new markup", success)
+}
+
+// TestDiffKeyedChildren validates the patches produced for keyed children.
+func TestDiffKeyedChildren(t *testing.T) {
+	old := keyedList("a", "b", "c")
+	newer := keyedList("c", "a", "d")
+
+	patches := trees.Diff(old, newer)
+
+	remove, ok := findPatch(patches, trees.RemoveChildPatch)
+	if !ok || remove.Target == "" {
+		t.Fatalf("\t%s\t Should have removed child by selector: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have removed child by selector", success)
+
+	insert, ok := findPatch(patches, trees.InsertChildPatch)
+	if !ok || insert.Index != 2 || insert.Before != "" {
+		t.Fatalf("\t%s\t Should have appended the new child: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have appended the new child", success)
+
+	move, ok := findPatch(patches, trees.MoveChildPatch)
+	if !ok || move.Index != 0 || move.Before != newer.Children()[1].EventID() {
+		t.Fatalf("\t%s\t Should have moved child before its next sibling: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have moved child before its next sibling", success)
+
+	if len(patches) != 3 {
+		t.Fatalf("\t%s\t Should have produced only 3 patches: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced only 3 patches", success)
+}
+
+// TestDiffReplaceRoot validates that differing root tags are replaced whole.
+func TestDiffReplaceRoot(t *testing.T) {
+	old := trees.NewMarkup("div", false)
+	newer := trees.NewMarkup("section", false)
+
+	patches := trees.Diff(old, newer)
+	if len(patches) != 1 || patches[0].Type != trees.ReplaceNodePatch || patches[0].Selector != old.EventID() {
+		t.Fatalf("\t%s\t Should have produced a replace node patch: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have produced a replace node patch", success)
+}
+
+// TestDiffKeyedTagChange validates that a keyed child which changes its tag is
+// replaced whole.
+func TestDiffKeyedTagChange(t *testing.T) {
+	old := trees.ParseFirstOrMakeRoot(`<ul><li key="1">One</li><li key="2">Two</li></ul>`)
+	newer := trees.ParseFirstOrMakeRoot(`<ul><li key="1">One</li><p key="2">Two</p></ul>`)
+
+	patches := trees.Diff(old, newer)
+
+	replace, ok := findPatch(patches, trees.ReplaceNodePatch)
+	if !ok || replace.Selector != old.Children()[1].EventID() {
+		t.Fatalf("\t%s\t Should have replaced the child of the changed tag: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have replaced the child of the changed tag", success)
+}
@@ -171,22 +171,37 @@ func Space(count int) *trees.Markup {
 		spaces = append(spaces, "&nbsp;")
 	}
 
-	return trees.NewText(strings.Join(spaces, ""))
+	return trees.RawHTML(strings.Join(spaces, ""))
 }
 
 // Markdown takes the giving string which contains markdown written contents
-// and parses to html, which then is used to generate a new markup.
-func Markdown(md string) *trees.Markup {
+// and parses to html, which then is used to generate a new markup. When
+// sanitizers are provided, the generated markup is passed through each of
+// them in turn.
+func Markdown(md string, sanitizers ...trees.Sanitizer) *trees.Markup {
 	hml := blackfriday.MarkdownCommon([]byte(md))
-	return Parse(string(hml))
+	if len(sanitizers) == 0 {
+		return Parse(string(hml))
+	}
+
+	tms := trees.Sanitize(trees.ParseTree(string(hml)), sanitizers...)
+	if len(tms) == 1 {
+		return tms[0]
+	}
+
+	sec := trees.NewMarkup("section", false)
+	sec.AddChild(tms...)
+	return sec
 }
 
 // MarkdownWithTemplate takes the giving template string which contains markdown
 // go template format strings. These will be parsed with text/template and using
 // blackfriday to parse the final output to html, which then is used to
-// generate a new markup. Any error is returned as a <error> tag markup.
-func MarkdownWithTemplate(md string, bind interface{}) *trees.Markup {
-	return trees.MarkdownTemplate(md, bind)
+// generate a new markup. Any error is returned as a <error> tag markup. When
+// sanitizers are provided, the generated markup is passed through each of
+// them in turn.
+func MarkdownWithTemplate(md string, bind interface{}, sanitizers ...trees.Sanitizer) *trees.Markup {
+	return trees.MarkdownTemplate(md, bind, sanitizers...)
 }
 
 // CustomElement defines a type which returns a custom element type provided by
@@ -0,0 +1,19 @@
+package elems
+
+import "github.com/gu-io/gu/trees"
+
+// OutletAttr defines the attribute which marks the element of a view into
+// which its nested views are rendered.
+const OutletAttr = "gu-outlet"
+
+// OutletSelector defines the css selector matching the elements returned by
+// Outlet.
+const OutletSelector = "[" + OutletAttr + "]"
+
+// Outlet provides a div marked as the outlet of a view, into which the nested
+// view matching the current route is rendered after the giving markup.
+func Outlet(markup ...trees.Appliable) *trees.Markup {
+	e := Div(markup...)
+	trees.NewAttr(OutletAttr, "").Apply(e)
+	return e
+}
@@ -0,0 +1,250 @@
+package trees
+
+import (
+	"regexp"
+	"strings"
+)
+
+//==============================================================================
+
+// FilteredValue defines the value written in place of attribute and style
+// values which are unsafe in the context they are written in, matching the
+// value used by html/template.
+const FilteredValue = "ZgotmplZ"
+
+// rawTextElements defines the elements whose text content is not parsed as html
+// by browsers, which therefore must not be escaped.
+var rawTextElements = map[string]bool{
+	"iframe":    true,
+	"noembed":   true,
+	"noframes":  true,
+	"noscript":  true,
+	"plaintext": true,
+	"script":    true,
+	"style":     true,
+	"xmp":       true,
+}
+
+// newlineElements defines the elements whose leading newline is dropped by
+// browsers, which therefore need an extra one written when their content
+// starts with a newline.
+var newlineElements = map[string]bool{
+	"listing":  true,
+	"pre":      true,
+	"textarea": true,
+}
+
+// urlAttributes defines the attributes whose values are loaded or followed by
+// browsers as urls.
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"codebase":   true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"icon":       true,
+	"longdesc":   true,
+	"manifest":   true,
+	"poster":     true,
+	"src":        true,
+	"srcset":     true,
+	"usemap":     true,
+	"xlink:href": true,
+}
+
+// scriptAttributes defines the attributes whose values are run by browsers as
+// scripts or loaded as whole documents, which are never written by the
+// printers and must be written through RawHTML instead. Attributes starting
+// with 'on' are event handlers and are left out as well.
+var scriptAttributes = map[string]bool{
+	"srcdoc": true,
+}
+
+// safeSchemes defines the url schemes allowed in url attributes.
+var safeSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// textEscaper escapes the characters which are significant in html text.
+var textEscaper = strings.NewReplacer(
+	`&`, "&amp;",
+	`<`, "&lt;",
+	`>`, "&gt;",
+)
+
+// attrEscaper escapes the characters which are significant in quoted html
+// attribute values.
+var attrEscaper = strings.NewReplacer(
+	`&`, "&amp;",
+	`<`, "&lt;",
+	`>`, "&gt;",
+	`"`, "&#34;",
+	`'`, "&#39;",
+)
+
+// unsafeCSS matches css values which can execute scripts or break out of the
+// declaration they are written in.
+var unsafeCSS = regexp.MustCompile(`(?i)expression|javascript:|vbscript:|-moz-binding|behavior|@import|[\\<>{};]|/\*`)
+
+// validCSSName matches the allowed names of css properties.
+var validCSSName = regexp.MustCompile(`^-?[a-zA-Z_][-a-zA-Z0-9_]*$`)
+
+// rawTextCloser matches the closing tag of raw text elements in any case.
+var rawTextCloser = regexp.MustCompile(`(?i)</(iframe|noembed|noframes|noscript|plaintext|script|style|xmp)`)
+
+// commentEscaper escapes the sequences which end html comments.
+var commentEscaper = strings.NewReplacer(
+	"-->", "--&gt;",
+	"--!>", "--!&gt;",
+)
+
+//==============================================================================
+
+// escapeText returns the giving text escaped for use as the content of the
+// giving element, which may be nil. Content of html raw text elements is left
+// as is with the exception of their closing tags, which are broken up so the
+// content can not end the element early.
+func escapeText(parent *Markup, text string) string {
+	if parent != nil && parent.namespace == "" && rawTextElements[parent.tagname] {
+		return rawTextCloser.ReplaceAllString(text, `<\/$1`)
+	}
+
+	return textEscaper.Replace(text)
+}
+
+// escapeComment returns the giving text escaped for use as the content of a
+// html comment.
+func escapeComment(text string) string {
+	text = commentEscaper.Replace(text)
+
+	if strings.HasPrefix(text, ">") || strings.HasPrefix(text, "->") {
+		text = strings.Replace(text, ">", "&gt;", 1)
+	}
+
+	return text
+}
+
+// escapeDoctype returns the giving content without the characters which end a
+// doctype declaration.
+func escapeDoctype(content string) string {
+	return strings.Replace(content, ">", "", -1)
+}
+
+// leadingNewline returns a newline for elements like 'pre' whose content
+// starts with a newline, as the first newline of their content gets dropped
+// when parsed.
+func leadingNewline(e *Markup) string {
+	if e.namespace != "" || !newlineElements[e.tagname] {
+		return ""
+	}
+
+	text := e.TextContent()
+	if text == "" && len(e.children) != 0 && e.children[0].Kind() == TextNode {
+		text = e.children[0].TextContent()
+	}
+
+	if strings.HasPrefix(text, "\n") {
+		return "\n"
+	}
+
+	return ""
+}
+
+// escapeAttr returns the giving attribute value escaped for use within the
+// quotes of the attribute with the giving name, filtering out urls with
+// unsafe schemes for url attributes.
+func escapeAttr(name string, value string) string {
+	switch name = strings.ToLower(name); {
+	case name == "srcset":
+		if !safeSrcset(value) {
+			value = "#" + FilteredValue
+		}
+	case urlAttributes[name] && !safeURL(value):
+		value = "#" + FilteredValue
+	}
+
+	return attrEscaper.Replace(value)
+}
+
+// escapeStyle returns the giving css value, or FilteredValue if the value is
+// unsafe for a style declaration.
+func escapeStyle(value string) string {
+	if unsafeCSS.MatchString(stripControls(value)) {
+		return FilteredValue
+	}
+
+	return value
+}
+
+// validAttrName returns true/false if the giving name can be written as an
+// attribute name without changing the meaning of the tag it's written in.
+func validAttrName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if r <= ' ' || r == 0x7f || strings.ContainsRune(`"'<>/=`, r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// scriptAttr returns true/false if the attribute with the giving name holds
+// scripts or documents, which makes it unsafe to write any value into.
+func scriptAttr(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasPrefix(name, "on") || scriptAttributes[name]
+}
+
+// safeSrcset returns true/false if every candidate of the giving srcset value
+// is safe, checking each candidate as a whole so whitespace within a scheme
+// can not hide it.
+func safeSrcset(srcset string) bool {
+	for _, candidate := range strings.Split(srcset, ",") {
+		if !safeURL(candidate) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// safeURL returns true/false if the giving url is relative or uses one of
+// the safeSchemes.
+func safeURL(url string) bool {
+	// browsers ignore whitespace and control characters within a scheme.
+	url = stripControls(url)
+
+	colon := strings.IndexRune(url, ':')
+	if colon < 0 {
+		return true
+	}
+
+	// a colon after a path, query or fragment does not start a scheme.
+	if end := strings.IndexAny(url, "/?#"); end >= 0 && end < colon {
+		return true
+	}
+
+	return safeSchemes[strings.ToLower(url[:colon])]
+}
+
+// stripControls returns the giving value without whitespace and control
+// characters.
+func stripControls(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+
+		return r
+	}, value)
+}
+
+//==============================================================================
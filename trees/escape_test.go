@@ -0,0 +1,237 @@
+package trees_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"golang.org/x/net/html"
+)
+
+// payloads contains injection payloads which must come out inert from the
+// printers in any context.
+var payloads = []string{
+	`<script>alert(1)</script>`,
+	`<img src=x onerror=alert(1)>`,
+	`"><svg onload=alert(1)>`,
+	`'><script>alert(1)</script>`,
+	`" onmouseover="alert(1)`,
+	`' onfocus='alert(1)' autofocus='`,
+	`</script><script>alert(1)</script>`,
+	`</style><script>alert(1)</script>`,
+	`</SCRIPT ><script>alert(1)</script>`,
+	`<!--<script>alert(1)</script>-->`,
+	`<![CDATA[<script>alert(1)</script>]]>`,
+	`javascript:alert(1)`,
+	`JaVaScRiPt:alert(1)`,
+	" java\tscript:alert(1)",
+	"java\nscript:alert(1)",
+	`vbscript:msgbox(1)`,
+	`data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==`,
+	`expression(alert(1))`,
+	`red;background:url(javascript:alert(1))`,
+	`red}</style><script>alert(1)</script>`,
+	`\61 lert(1)`,
+	`/* */ behavior: url(x.htc)`,
+}
+
+// renderInContexts returns a markup using the payload as text, attribute,
+// url, inline style and script content.
+func renderInContexts(payload string) *trees.Markup {
+	root := trees.NewMarkup("div", false)
+	trees.NewAttr("title", payload).Apply(root)
+	trees.NewAttr("onclick", payload).Apply(root)
+	trees.NewCSSStyle("color", payload).Apply(root)
+	trees.NewText(payload).Apply(root)
+
+	link := trees.NewMarkup("a", false)
+	trees.NewAttr("href", payload).Apply(link)
+	trees.NewText(payload).Apply(link)
+	link.Apply(root)
+
+	img := trees.NewMarkup("img", true)
+	trees.NewAttr("src", payload).Apply(img)
+	trees.NewAttr("srcset", payload+" 2x, /img.png 1x").Apply(img)
+	trees.NewAttr("alt", payload).Apply(img)
+	img.Apply(root)
+
+	script := trees.NewMarkup("script", false)
+	trees.NewText("var payload = %q;", payload).Apply(script)
+	script.Apply(root)
+
+	style := trees.NewMarkup("style", false)
+	trees.NewText("div { content: %q; }", payload).Apply(style)
+	style.Apply(root)
+
+	textarea := trees.NewMarkup("textarea", false)
+	trees.NewText(payload).Apply(textarea)
+	textarea.Apply(root)
+
+	frame := trees.NewMarkup("iframe", false)
+	trees.NewAttr("srcdoc", payload).Apply(frame)
+	trees.NewAttr("OnLoad", payload).Apply(frame)
+	frame.Apply(root)
+
+	return root
+}
+
+// inert returns a description of the first active content found in the html,
+// if any.
+func inert(markup string) (string, bool) {
+	tokens := html.NewTokenizer(strings.NewReader(markup))
+
+	var tags []string
+
+	for {
+		switch tokens.Next() {
+		case html.ErrorToken:
+			if strings.Join(tags, ",") != "div,a,img,script,style,textarea,iframe" {
+				return "unexpected elements: " + strings.Join(tags, ","), false
+			}
+
+			return "", true
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokens.Token()
+			tags = append(tags, token.Data)
+
+			for _, attr := range token.Attr {
+				value := strings.ToLower(strings.Map(func(r rune) rune {
+					if r <= ' ' {
+						return -1
+					}
+					return r
+				}, attr.Val))
+
+				switch {
+				case strings.HasPrefix(attr.Key, "on"):
+					return "event handler attribute: " + attr.Key, false
+				case attr.Key == "srcdoc":
+					return "document attribute: " + attr.Key, false
+				case attr.Key == "srcset":
+					for _, candidate := range strings.Split(value, ",") {
+						if strings.HasPrefix(candidate, "javascript:") || strings.HasPrefix(candidate, "vbscript:") || strings.HasPrefix(candidate, "data:") {
+							return "unsafe srcset: " + attr.Val, false
+						}
+					}
+				case attr.Key == "href" || attr.Key == "src":
+					if strings.HasPrefix(value, "javascript:") || strings.HasPrefix(value, "vbscript:") || strings.HasPrefix(value, "data:") {
+						return "unsafe url: " + attr.Val, false
+					}
+				case attr.Key == "style":
+					if strings.Contains(value, "expression") || strings.Contains(value, "javascript:") || strings.Contains(value, "behavior") || strings.Contains(value, "}") {
+						return "unsafe style: " + attr.Val, false
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestEscapingCorpus validates injection payloads come out inert from the
+// default printers in every context.
+func TestEscapingCorpus(t *testing.T) {
+	for _, payload := range payloads {
+		markup := renderInContexts(payload)
+
+		var streamed bytes.Buffer
+		if _, err := trees.SimpleElementWriter.WriteTo(&streamed, markup); err != nil {
+			t.Fatalf("\t%s\t Should have streamed markup without error: %q", failed, err)
+		}
+
+		for _, out := range []string{markup.HTML(), streamed.String()} {
+			if reason, ok := inert(out); !ok {
+				t.Fatalf("\t%s\t Should have rendered payload %q inert: %s\n%s", failed, payload, reason, out)
+			}
+		}
+	}
+	t.Logf("\t%s\t Should have rendered all payloads inert", success)
+}
+
+// TestEscaping validates safe content is escaped without changing its meaning.
+func TestEscaping(t *testing.T) {
+	defer trees.SetMode(trees.GetMode())
+	trees.SetMode(trees.Pretty)
+
+	link := trees.NewMarkup("a", false)
+	trees.NewAttr("href", "/search?q=tom&page=2").Apply(link)
+	trees.NewAttr("title", `Tom "&" Jerry`).Apply(link)
+	trees.NewCSSStyle("font-family", `"Open Sans"`).Apply(link)
+	trees.NewText("Tom & Jerry <3").Apply(link)
+
+	expected := `<a data-gen="gu"  href="/search?q=tom&amp;page=2"  title="Tom &#34;&amp;&#34; Jerry" style=" font-family:&#34;Open Sans&#34;;">Tom &amp; Jerry &lt;3</a>`
+	if out := link.HTML(); out != expected {
+		t.Fatalf("\t%s\t Should have escaped text and attributes: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have escaped text and attributes", success)
+
+	mail := trees.NewMarkup("a", false)
+	trees.NewAttr("href", "mailto:tom@example.com").Apply(mail)
+
+	if out := mail.HTML(); !strings.Contains(out, `href="mailto:tom@example.com"`) {
+		t.Fatalf("\t%s\t Should have kept urls with safe schemes: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have kept urls with safe schemes", success)
+
+	script := trees.NewMarkup("script", false)
+	trees.NewText(`if (a < b && c > d) { run("</script>"); }`).Apply(script)
+
+	if out := script.HTML(); out != `<script data-gen="gu" style="">if (a < b && c > d) { run("<\/script>"); }</script>` {
+		t.Fatalf("\t%s\t Should have left script content unescaped except its closing tag: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have left script content unescaped except its closing tag", success)
+
+	bad := trees.NewMarkup("div", false)
+	trees.NewAttr(`onclick="alert(1)" x`, "y").Apply(bad)
+	trees.NewCSSStyle("color:red;x", "blue").Apply(bad)
+
+	if out := bad.HTML(); out != `<div data-gen="gu" style=""></div>` {
+		t.Fatalf("\t%s\t Should have left out attributes and styles with invalid names: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have left out attributes and styles with invalid names", success)
+
+	frame := trees.NewMarkup("iframe", false)
+	trees.NewAttr("srcdoc", "<p>hello</p>").Apply(frame)
+	trees.NewAttr("onload", "resize()").Apply(frame)
+
+	if out := frame.HTML(); out != `<iframe data-gen="gu" style=""></iframe>` {
+		t.Fatalf("\t%s\t Should have left out script attributes: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have left out script attributes", success)
+
+	img := trees.NewMarkup("img", true)
+	trees.NewAttr("srcset", "/small.png 1x, /large.png 2x").Apply(img)
+
+	if out := img.HTML(); !strings.Contains(out, `srcset="/small.png 1x, /large.png 2x"`) {
+		t.Fatalf("\t%s\t Should have kept srcset with safe urls: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have kept srcset with safe urls", success)
+
+	bad = trees.NewMarkup("img", true)
+	trees.NewAttr("srcset", "/small.png 1x, javascript:alert(1) 2x").Apply(bad)
+
+	if out := bad.HTML(); !strings.Contains(out, `srcset="#ZgotmplZ"`) {
+		t.Fatalf("\t%s\t Should have filtered srcset with unsafe urls: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have filtered srcset with unsafe urls", success)
+}
+
+// TestRawHTML validates raw html is written out as is.
+func TestRawHTML(t *testing.T) {
+	defer trees.SetMode(trees.GetMode())
+	trees.SetMode(trees.Pretty)
+
+	root := trees.NewMarkup("div", false)
+	trees.RawHTML("<b>trusted</b>&nbsp;").Apply(root)
+
+	if out := root.HTML(); out != `<div data-gen="gu" style=""><b>trusted</b>&nbsp;</div>` {
+		t.Fatalf("\t%s\t Should have written raw html as is: %q", failed, out)
+	}
+	t.Logf("\t%s\t Should have written raw html as is", success)
+
+	if !root.Clone().Children()[0].Raw() {
+		t.Fatalf("\t%s\t Should have kept raw html when cloned", failed)
+	}
+	t.Logf("\t%s\t Should have kept raw html when cloned", success)
+}
@@ -3,6 +3,7 @@ package trees
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gu-io/gu/common"
 )
@@ -53,6 +54,45 @@ func PreventDefault(state bool) EventOptions {
 	}
 }
 
+// Debounce sets the event to be delivered only once no other occurrence of it
+// happened within the giving duration, delivering the last occurrence.
+func Debounce(duration time.Duration) EventOptions {
+	return func(ev *Event) {
+		ev.Debounce = duration
+	}
+}
+
+// Throttle sets the event to be delivered at most once within the giving
+// duration, dropping the occurrences in between.
+func Throttle(duration time.Duration) EventOptions {
+	return func(ev *Event) {
+		ev.Throttle = duration
+	}
+}
+
+// Once sets the event to be delivered only for its first occurrence.
+func Once() EventOptions {
+	return func(ev *Event) {
+		ev.Once = true
+	}
+}
+
+// Passive sets the event listener to be passive, promising the browser it
+// never prevents the default action, which is ignored if set.
+func Passive() EventOptions {
+	return func(ev *Event) {
+		ev.Passive = true
+	}
+}
+
+// OnKeys sets the event to be delivered only for keyboard events whose key is
+// one of the giving keys, like "Enter" or "Escape".
+func OnKeys(keys ...string) EventOptions {
+	return func(ev *Event) {
+		ev.Keys = append(ev.Keys, keys...)
+	}
+}
+
 // Event provide a meta registry for helps in registering events for dom markups
 // which is translated to the nodes themselves
 type Event struct {
@@ -61,9 +101,18 @@ type Event struct {
 	StopPropagation          bool
 	UseCapture               bool
 	StopImmediatePropagation bool
+	Once                     bool
+	Passive                  bool
+	Debounce                 time.Duration
+	Throttle                 time.Duration
+	Keys                     []string
 	Tree                     *Markup
 	Remove                   common.Remover
 	secTarget                string
+
+	// Handler is called with the event objects delivered for the event by the
+	// app whose views it is rendered in, along with the markup it belongs to.
+	Handler func(common.EventObject, *Markup)
 }
 
 // NewEvent returns a event object that allows registering events to eventlisteners.
@@ -92,6 +141,7 @@ func (e *Event) Target() string {
 // EventJSON defines a struct which contains the giving events and
 // and tree of the giving tree.
 type EventJSON struct {
+	EventID                  string `json:"EventID"`
 	ParentSelector           string `json:"ParentSelector"`
 	EventSelector            string `json:"EventSelector"`
 	EventName                string `json:"EventName"`
@@ -100,11 +150,19 @@ type EventJSON struct {
 	StopPropagation          bool   `json:"StopPropagation"`
 	UseCapture               bool   `json:"UseCapture"`
 	StopImmediatePropagation bool   `json:"StopImmediatePropagation"`
+
+	// Debounce and Throttle are in milliseconds.
+	Once     bool     `json:"Once,omitempty"`
+	Passive  bool     `json:"Passive,omitempty"`
+	Debounce int64    `json:"Debounce,omitempty"`
+	Throttle int64    `json:"Throttle,omitempty"`
+	Keys     []string `json:"Keys,omitempty"`
 }
 
 // EventJSON returns the event json structure which represent the giving event.
 func (e *Event) EventJSON() EventJSON {
 	return EventJSON{
+		EventID:                  e.ID(),
 		Event:                    e.Type,
 		UseCapture:               e.UseCapture,
 		EventName:                e.EventName(),
@@ -113,6 +171,11 @@ func (e *Event) EventJSON() EventJSON {
 		PreventDefault:           e.PreventDefault,
 		StopPropagation:          e.StopPropagation,
 		StopImmediatePropagation: e.StopImmediatePropagation,
+		Once:                     e.Once,
+		Passive:                  e.Passive,
+		Debounce:                 int64(e.Debounce / time.Millisecond),
+		Throttle:                 int64(e.Throttle / time.Millisecond),
+		Keys:                     e.Keys,
 	}
 }
 
@@ -158,6 +221,12 @@ func (e *Event) Clone() *Event {
 		UseCapture:               e.UseCapture,
 		StopPropagation:          e.StopPropagation,
 		StopImmediatePropagation: e.StopImmediatePropagation,
+		Once:                     e.Once,
+		Passive:                  e.Passive,
+		Debounce:                 e.Debounce,
+		Throttle:                 e.Throttle,
+		Keys:                     append([]string(nil), e.Keys...),
+		Handler:                  e.Handler,
 	}
 }
 
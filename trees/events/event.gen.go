@@ -4,12 +4,15 @@
 
 // Documentation source: "Event reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/Events, licensed under CC-BY-SA 2.5.
 
-//Package events defines the event binding system that combines different libraries to create a interesting event system.
+// Package events defines the event binding system that combines different libraries to create a interesting event system.
+//
+// The events are created with their DOM type as the trees.EventType, the name given to addEventListener like "keydown",
+// "dblclick" or "CssRuleViewChanged", and no longer the name of their function like "KeyDown" or "CSSRuleViewChange".
 package events
 
 import (
 	"github.com/gu-io/gu/common"
-	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/eventx"
 	"github.com/gu-io/gu/trees"
 )
 
@@ -55,16 +58,7 @@ func AbortEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("abort")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -89,19 +83,10 @@ func AfterPrintEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("AfterPrint")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("afterprint")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -126,19 +111,10 @@ func AfterScriptExecuteEvent(callback interface{}, options ...trees.EventOptions
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("AfterScriptExecute")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("afterscriptexecute")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -166,16 +142,7 @@ func AlertActiveEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("AlertActive")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -203,16 +170,7 @@ func AlertCloseEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("AlertClose")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -240,16 +198,7 @@ func AlertingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("alerting")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -274,23 +223,25 @@ func AnimationEndEvent(callback interface{}, options ...trees.EventOptions) *tre
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("AnimationEnd")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("animationend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnAnimationEnd returns a AnimationEndEvent whose callback receives the event as a
+// *eventx.AnimationEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.AnimationEvent are not delivered to the callback.
+func OnAnimationEnd(callback func(*eventx.AnimationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AnimationEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.AnimationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // AnimationIterationEvent Documentation is as below: "A CSS animation is repeated."
 // https://developer.mozilla.org/docs/Web/Events/animationiteration
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -311,23 +262,25 @@ func AnimationIterationEvent(callback interface{}, options ...trees.EventOptions
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("AnimationIteration")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("animationiteration")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnAnimationIteration returns a AnimationIterationEvent whose callback receives the event as a
+// *eventx.AnimationEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.AnimationEvent are not delivered to the callback.
+func OnAnimationIteration(callback func(*eventx.AnimationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AnimationIterationEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.AnimationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // AnimationStartEvent Documentation is as below: "A CSS animation has started."
 // https://developer.mozilla.org/docs/Web/Events/animationstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -348,23 +301,25 @@ func AnimationStartEvent(callback interface{}, options ...trees.EventOptions) *t
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("AnimationStart")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("animationstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnAnimationStart returns a AnimationStartEvent whose callback receives the event as a
+// *eventx.AnimationEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.AnimationEvent are not delivered to the callback.
+func OnAnimationStart(callback func(*eventx.AnimationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AnimationStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.AnimationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // AppinstalledEvent Documentation is as below: "A web application\u00a0is successfully installed as a progressive web app."
 // https://developer.mozilla.org/docs/Web/Events/appinstalled
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -388,16 +343,7 @@ func AppinstalledEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("appinstalled")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -422,19 +368,10 @@ func AudioProcessEvent(callback interface{}, options ...trees.EventOptions) *tre
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("AudioProcess")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("audioprocess")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -462,16 +399,7 @@ func AudioendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("audioend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -499,27 +427,18 @@ func AudiostartEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("audiostart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// AuxclickEvent Documentation is as below: "(no documentation)"
+// AuxClickEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/auxclick
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func AuxClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -536,18 +455,27 @@ func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("auxclick")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// AuxclickEvent returns a AuxClickEvent.
+//
+// Deprecated: use AuxClickEvent.
+func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return AuxClickEvent(callback, options...)
+}
 
-	return ev
+// OnAuxClick returns a AuxClickEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnAuxClick(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AuxClickEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
 // BeforeInstallPromptEvent Documentation is as below: "A user is prompted to save a web site to a home screen on mobile."
@@ -570,19 +498,10 @@ func BeforeInstallPromptEvent(callback interface{}, options ...trees.EventOption
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("BeforeInstallPrompt")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("beforeinstallprompt")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -607,19 +526,10 @@ func BeforePrintEvent(callback interface{}, options ...trees.EventOptions) *tree
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("BeforePrint")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("beforeprint")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -644,19 +554,10 @@ func BeforeScriptExecuteEvent(callback interface{}, options ...trees.EventOption
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("BeforeScriptExecute")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("beforescriptexecute")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -681,23 +582,25 @@ func BeforeUnloadEvent(callback interface{}, options ...trees.EventOptions) *tre
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("BeforeUnload")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("beforeunload")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnBeforeUnload returns a BeforeUnloadEvent whose callback receives the event as a
+// *eventx.BeforeUnloadEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.BeforeUnloadEvent are not delivered to the callback.
+func OnBeforeUnload(callback func(*eventx.BeforeUnloadEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return BeforeUnloadEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.BeforeUnloadEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // BeginEventEvent Documentation is as below: "A SMIL animation element begins."
 // https://developer.mozilla.org/docs/Web/Events/beginEvent
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -721,16 +624,7 @@ func BeginEventEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("beginEvent")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -758,16 +652,7 @@ func BlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("blocked")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -795,20 +680,22 @@ func BlurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("blur")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnBlur returns a BlurEvent whose callback receives the event as a
+// *eventx.FocusEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.FocusEvent are not delivered to the callback.
+func OnBlur(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return BlurEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // BoundaryEvent Documentation is as below: "The spoken utterance reaches a word or sentence boundary"
 // https://developer.mozilla.org/docs/Web/Events/boundary
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -832,16 +719,7 @@ func BoundaryEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("boundary")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -869,16 +747,7 @@ func BroadcastEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("broadcast")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -906,16 +775,7 @@ func BusyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("busy")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -940,19 +800,10 @@ func CSSRuleViewCSSLinkClickedEvent(callback interface{}, options ...trees.Event
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CSSRuleViewCSSLinkClicked")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("CssRuleViewCSSLinkClicked")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -977,19 +828,10 @@ func CSSRuleViewChangeEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CSSRuleViewChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("CssRuleViewChanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1014,19 +856,10 @@ func CSSRuleViewRefreshedEvent(callback interface{}, options ...trees.EventOptio
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CSSRuleViewRefreshed")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("CssRuleViewRefreshed")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1054,16 +887,7 @@ func CachedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("cached")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1091,16 +915,7 @@ func CallschangedEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("callschanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1125,19 +940,10 @@ func CanPlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CanPlay")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("canplay")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1162,19 +968,10 @@ func CanPlayThroughEvent(callback interface{}, options ...trees.EventOptions) *t
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CanPlayThrough")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("canplaythrough")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1202,16 +999,7 @@ func CardstatechangeEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("cardstatechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1239,16 +1027,7 @@ func CfstatechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("cfstatechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1276,20 +1055,22 @@ func ChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("change")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnChange returns a ChangeEvent whose callback receives the event as a
+// *eventx.ChangeEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.ChangeEvent are not delivered to the callback.
+func OnChange(callback func(*eventx.ChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ChangeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // ChargingChangeEvent Documentation is as below: "The battery begins or stops charging."
 // https://developer.mozilla.org/docs/Web/Events/chargingchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1310,19 +1091,10 @@ func ChargingChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("ChargingChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("chargingchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1347,19 +1119,10 @@ func ChargingTimeChangeEvent(callback interface{}, options ...trees.EventOptions
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("ChargingTimeChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("chargingtimechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1387,16 +1150,7 @@ func CheckboxStateChangeEvent(callback interface{}, options ...trees.EventOption
 	ops := append([]trees.EventOptions{trees.EventType("CheckboxStateChange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1424,16 +1178,7 @@ func CheckingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("checking")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1461,20 +1206,22 @@ func ClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("click")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnClick returns a ClickEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnClick(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ClickEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CloseEvent Documentation is as below: "The close button of the window has been clicked."
 // https://developer.mozilla.org/docs/Web/Reference/Events/close_event
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1498,16 +1245,7 @@ func CloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("close")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1535,16 +1273,7 @@ func CommandEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("command")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1572,16 +1301,7 @@ func CommandupdateEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("commandupdate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1609,16 +1329,7 @@ func CompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("complete")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1643,23 +1354,25 @@ func CompositionEndEvent(callback interface{}, options ...trees.EventOptions) *t
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CompositionEnd")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("compositionend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnCompositionEnd returns a CompositionEndEvent whose callback receives the event as a
+// *eventx.CompositionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.CompositionEvent are not delivered to the callback.
+func OnCompositionEnd(callback func(*eventx.CompositionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CompositionEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.CompositionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CompositionStartEvent Documentation is as below: "The composition of a passage of text is prepared (similar to keydown for a keyboard input, but works with other inputs such as speech recognition)."
 // https://developer.mozilla.org/docs/Web/Events/compositionstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1680,23 +1393,25 @@ func CompositionStartEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CompositionStart")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("compositionstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnCompositionStart returns a CompositionStartEvent whose callback receives the event as a
+// *eventx.CompositionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.CompositionEvent are not delivered to the callback.
+func OnCompositionStart(callback func(*eventx.CompositionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CompositionStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.CompositionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CompositionUpdateEvent Documentation is as below: "A character is added to a passage of text being composed."
 // https://developer.mozilla.org/docs/Web/Events/compositionupdate
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1717,23 +1432,25 @@ func CompositionUpdateEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("CompositionUpdate")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("compositionupdate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnCompositionUpdate returns a CompositionUpdateEvent whose callback receives the event as a
+// *eventx.CompositionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.CompositionEvent are not delivered to the callback.
+func OnCompositionUpdate(callback func(*eventx.CompositionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CompositionUpdateEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.CompositionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // ConnectingEvent Documentation is as below: "A call is about to connect."
 // https://developer.mozilla.org/docs/Web/Events/connecting
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1757,16 +1474,7 @@ func ConnectingEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("connecting")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1794,16 +1502,7 @@ func ConnectionInfoUpdateEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("connectionInfoUpdate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1828,23 +1527,25 @@ func ContextMenuEvent(callback interface{}, options ...trees.EventOptions) *tree
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("ContextMenu")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("contextmenu")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnContextMenu returns a ContextMenuEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnContextMenu(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ContextMenuEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CopyEvent Documentation is as below: "The text selection has been added to the clipboard."
 // https://developer.mozilla.org/docs/Web/Events/copy
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1868,20 +1569,22 @@ func CopyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("copy")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnCopy returns a CopyEvent whose callback receives the event as a
+// *eventx.ClipboardEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.ClipboardEvent are not delivered to the callback.
+func OnCopy(callback func(*eventx.ClipboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CopyEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ClipboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CutEvent Documentation is as below: "The text selection has been removed from the document and added to the clipboard."
 // https://developer.mozilla.org/docs/Web/Events/cut
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1905,20 +1608,22 @@ func CutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("cut")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnCut returns a CutEvent whose callback receives the event as a
+// *eventx.ClipboardEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.ClipboardEvent are not delivered to the callback.
+func OnCut(callback func(*eventx.ClipboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ClipboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DOMAutoCompleteEvent Documentation is as below: "The content of an element has been auto-completed."
 // https://developer.mozilla.org/docs/Web/Reference/Events/DOMAutoComplete
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1942,16 +1647,7 @@ func DOMAutoCompleteEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("DOMAutoComplete")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -1979,16 +1675,7 @@ func DOMContentLoadedEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("DOMContentLoaded")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2016,16 +1703,7 @@ func DOMFrameContentLoadedEvent(callback interface{}, options ...trees.EventOpti
 	ops := append([]trees.EventOptions{trees.EventType("DOMFrameContentLoaded")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2053,16 +1731,7 @@ func DOMLinkAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("DOMLinkAdded")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2090,16 +1759,7 @@ func DOMLinkRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 	ops := append([]trees.EventOptions{trees.EventType("DOMLinkRemoved")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2127,16 +1787,7 @@ func DOMMenuItemActiveEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("DOMMenuItemActive")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2164,16 +1815,7 @@ func DOMMenuItemInactiveEvent(callback interface{}, options ...trees.EventOption
 	ops := append([]trees.EventOptions{trees.EventType("DOMMenuItemInactive")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2201,16 +1843,7 @@ func DOMMetaAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("DOMMetaAdded")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2238,16 +1871,7 @@ func DOMMetaRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 	ops := append([]trees.EventOptions{trees.EventType("DOMMetaRemoved")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2275,16 +1899,7 @@ func DOMModalDialogClosedEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("DOMModalDialogClosed")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2312,16 +1927,7 @@ func DOMPopupBlockedEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("DOMPopupBlocked")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2349,16 +1955,7 @@ func DOMTitleChangedEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("DOMTitleChanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2386,16 +1983,7 @@ func DOMWillOpenModalDialogEvent(callback interface{}, options ...trees.EventOpt
 	ops := append([]trees.EventOptions{trees.EventType("DOMWillOpenModalDialog")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2423,16 +2011,7 @@ func DOMWindowCloseEvent(callback interface{}, options ...trees.EventOptions) *t
 	ops := append([]trees.EventOptions{trees.EventType("DOMWindowClose")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2460,16 +2039,7 @@ func DOMWindowCreatedEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("DOMWindowCreated")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2497,16 +2067,7 @@ func DatachangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("datachange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2534,16 +2095,7 @@ func DataerrorEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("dataerror")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2568,23 +2120,25 @@ func DblClickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DblClick")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("dblclick")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDblClick returns a DblClickEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnDblClick(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DblClickEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeliveredEvent Documentation is as below: "An SMS has been successfully delivered."
 // https://developer.mozilla.org/docs/Web/Events/delivered
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2608,16 +2162,7 @@ func DeliveredEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("delivered")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2642,23 +2187,25 @@ func DeviceLightEvent(callback interface{}, options ...trees.EventOptions) *tree
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DeviceLight")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("devicelight")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDeviceLight returns a DeviceLightEvent whose callback receives the event as a
+// *eventx.DeviceLightEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DeviceLightEvent are not delivered to the callback.
+func OnDeviceLight(callback func(*eventx.DeviceLightEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceLightEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceLightEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeviceMotionEvent Documentation is as below: "Fresh data is available from a motion sensor."
 // https://developer.mozilla.org/docs/Web/Events/devicemotion
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2679,23 +2226,25 @@ func DeviceMotionEvent(callback interface{}, options ...trees.EventOptions) *tre
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DeviceMotion")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("devicemotion")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDeviceMotion returns a DeviceMotionEvent whose callback receives the event as a
+// *eventx.DeviceMotionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DeviceMotionEvent are not delivered to the callback.
+func OnDeviceMotion(callback func(*eventx.DeviceMotionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceMotionEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceMotionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeviceOrientationEvent Documentation is as below: "Fresh data is available from an orientation sensor."
 // https://developer.mozilla.org/docs/Web/Events/deviceorientation
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2716,23 +2265,25 @@ func DeviceOrientationEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DeviceOrientation")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("deviceorientation")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDeviceOrientation returns a DeviceOrientationEvent whose callback receives the event as a
+// *eventx.DeviceOrientationEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DeviceOrientationEvent are not delivered to the callback.
+func OnDeviceOrientation(callback func(*eventx.DeviceOrientationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceOrientationEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceOrientationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeviceProximityEvent Documentation is as below: "Fresh data is available from a proximity sensor (indicates an approximated distance between the device and a nearby object)."
 // https://developer.mozilla.org/docs/Web/Events/deviceproximity
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2753,23 +2304,25 @@ func DeviceProximityEvent(callback interface{}, options ...trees.EventOptions) *
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DeviceProximity")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("deviceproximity")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDeviceProximity returns a DeviceProximityEvent whose callback receives the event as a
+// *eventx.DeviceProximityEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DeviceProximityEvent are not delivered to the callback.
+func OnDeviceProximity(callback func(*eventx.DeviceProximityEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceProximityEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceProximityEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DevicechangeEvent Documentation is as below: "A media device such as a camera, microphone, or speaker is connected or removed from the system."
 // https://developer.mozilla.org/docs/Web/Events/devicechange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2793,16 +2346,7 @@ func DevicechangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("devicechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2830,16 +2374,7 @@ func DialingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("dialing")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2867,16 +2402,7 @@ func DisabledEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("disabled")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2901,19 +2427,10 @@ func DischargingTimeChangeEvent(callback interface{}, options ...trees.EventOpti
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DischargingTimeChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("dischargingtimechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2941,16 +2458,7 @@ func DisconnectedEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("disconnected")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -2978,16 +2486,7 @@ func DisconnectingEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("disconnecting")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3015,16 +2514,7 @@ func DownloadingEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("downloading")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3052,20 +2542,22 @@ func DragEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("drag")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDrag returns a DragEvent whose callback receives the event as a
+// *eventx.DragEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DragEvent are not delivered to the callback.
+func OnDrag(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragEndEvent Documentation is as below: "A drag operation is being ended (by releasing a mouse button or hitting the escape key)."
 // https://developer.mozilla.org/docs/Web/Events/dragend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3086,23 +2578,25 @@ func DragEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DragEnd")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("dragend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDragEnd returns a DragEndEvent whose callback receives the event as a
+// *eventx.DragEndEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DragEndEvent are not delivered to the callback.
+func OnDragEnd(callback func(*eventx.DragEndEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEndEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragEnterEvent Documentation is as below: "A dragged element or text selection enters a valid drop target."
 // https://developer.mozilla.org/docs/Web/Events/dragenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3123,23 +2617,25 @@ func DragEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DragEnter")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("dragenter")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDragEnter returns a DragEnterEvent whose callback receives the event as a
+// *eventx.DragEnterEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DragEnterEvent are not delivered to the callback.
+func OnDragEnter(callback func(*eventx.DragEnterEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragEnterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEnterEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragLeaveEvent Documentation is as below: "A dragged element or text selection leaves a valid drop target."
 // https://developer.mozilla.org/docs/Web/Events/dragleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3160,23 +2656,25 @@ func DragLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DragLeave")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("dragleave")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDragLeave returns a DragLeaveEvent whose callback receives the event as a
+// *eventx.DragLeaveEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DragLeaveEvent are not delivered to the callback.
+func OnDragLeave(callback func(*eventx.DragLeaveEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragLeaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragLeaveEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragOverEvent Documentation is as below: "An element or text selection is being dragged over a valid drop target (every 350ms)."
 // https://developer.mozilla.org/docs/Web/Events/dragover
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3197,23 +2695,25 @@ func DragOverEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DragOver")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("dragover")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDragOver returns a DragOverEvent whose callback receives the event as a
+// *eventx.DragOverEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DragOverEvent are not delivered to the callback.
+func OnDragOver(callback func(*eventx.DragOverEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragOverEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragOverEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragStartEvent Documentation is as below: "The user starts dragging an element or text selection."
 // https://developer.mozilla.org/docs/Web/Events/dragstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3234,23 +2734,25 @@ func DragStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DragStart")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("dragstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDragStart returns a DragStartEvent whose callback receives the event as a
+// *eventx.DragStartEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DragStartEvent are not delivered to the callback.
+func OnDragStart(callback func(*eventx.DragStartEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragStartEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DropEvent Documentation is as below: "An element is dropped on a valid drop target."
 // https://developer.mozilla.org/docs/Web/Events/drop
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3274,20 +2776,22 @@ func DropEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("drop")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnDrop returns a DropEvent whose callback receives the event as a
+// *eventx.DropEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.DropEvent are not delivered to the callback.
+func OnDrop(callback func(*eventx.DropEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DropEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DropEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DurationChangeEvent Documentation is as below: "The duration attribute has been updated."
 // https://developer.mozilla.org/docs/Web/Events/durationchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3308,19 +2812,10 @@ func DurationChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("DurationChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("durationchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3348,16 +2843,7 @@ func EmptiedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("emptied")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3385,16 +2871,7 @@ func EnabledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("enabled")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3422,16 +2899,7 @@ func EndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("end")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3459,16 +2927,7 @@ func EndEventEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("endEvent")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3496,16 +2955,7 @@ func EndedEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("ended")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3533,20 +2983,22 @@ func FocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("focus")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnFocus returns a FocusEvent whose callback receives the event as a
+// *eventx.FocusEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.FocusEvent are not delivered to the callback.
+func OnFocus(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return FocusEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // FocusInEvent Documentation is as below: "An element is about to receive focus (bubbles)."
 // https://developer.mozilla.org/docs/Web/Events/focusin
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3567,23 +3019,25 @@ func FocusInEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("FocusIn")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("focusin")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnFocusIn returns a FocusInEvent whose callback receives the event as a
+// *eventx.FocusEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.FocusEvent are not delivered to the callback.
+func OnFocusIn(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return FocusInEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // FocusOutEvent Documentation is as below: "An element is about to lose focus (bubbles)."
 // https://developer.mozilla.org/docs/Web/Events/focusout
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3604,23 +3058,25 @@ func FocusOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("FocusOut")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("focusout")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnFocusOut returns a FocusOutEvent whose callback receives the event as a
+// *eventx.FocusEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.FocusEvent are not delivered to the callback.
+func OnFocusOut(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return FocusOutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // FullScreenChangeEvent Documentation is as below: "An element was turned to fullscreen mode or back to normal mode."
 // https://developer.mozilla.org/docs/Web/Events/fullscreenchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3641,19 +3097,10 @@ func FullScreenChangeEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("FullScreenChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("fullscreenchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3678,19 +3125,10 @@ func FullScreenErrorEvent(callback interface{}, options ...trees.EventOptions) *
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("FullScreenError")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("fullscreenerror")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3718,16 +3156,7 @@ func FullscreenEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("fullscreen")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3752,23 +3181,25 @@ func GamepadConnectedEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("GamepadConnected")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("gamepadconnected")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnGamepadConnected returns a GamepadConnectedEvent whose callback receives the event as a
+// *eventx.GamepadEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.GamepadEvent are not delivered to the callback.
+func OnGamepadConnected(callback func(*eventx.GamepadEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return GamepadConnectedEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.GamepadEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // GamepadDisconnectedEvent Documentation is as below: "A gamepad has been disconnected."
 // https://developer.mozilla.org/docs/Web/Events/gamepaddisconnected
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3789,30 +3220,32 @@ func GamepadDisconnectedEvent(callback interface{}, options ...trees.EventOption
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("GamepadDisconnected")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("gamepaddisconnected")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// GotpointercaptureEvent Documentation is as below: "Element receives pointer capture."
+// OnGamepadDisconnected returns a GamepadDisconnectedEvent whose callback receives the event as a
+// *eventx.GamepadEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.GamepadEvent are not delivered to the callback.
+func OnGamepadDisconnected(callback func(*eventx.GamepadEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return GamepadDisconnectedEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.GamepadEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
+// GotPointerCaptureEvent Documentation is as below: "Element receives pointer capture."
 // https://developer.mozilla.org/docs/Web/Events/gotpointercapture
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func GotPointerCaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -3829,18 +3262,27 @@ func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("gotpointercapture")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// GotpointercaptureEvent returns a GotPointerCaptureEvent.
+//
+// Deprecated: use GotPointerCaptureEvent.
+func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return GotPointerCaptureEvent(callback, options...)
+}
 
-	return ev
+// OnGotPointerCapture returns a GotPointerCaptureEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnGotPointerCapture(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return GotPointerCaptureEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
 // HashChangeEvent Documentation is as below: "The fragment identifier of the URL has changed (the part of the URL after the #)."
@@ -3863,23 +3305,25 @@ func HashChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("HashChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("hashchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnHashChange returns a HashChangeEvent whose callback receives the event as a
+// *eventx.HashChangeEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.HashChangeEvent are not delivered to the callback.
+func OnHashChange(callback func(*eventx.HashChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return HashChangeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.HashChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // HeldEvent Documentation is as below: "A call has been held."
 // https://developer.mozilla.org/docs/Web/Events/held
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3903,16 +3347,7 @@ func HeldEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("held")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3940,16 +3375,7 @@ func HoldingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("holding")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -3977,16 +3403,7 @@ func IcccardlockerrorEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("icccardlockerror")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4014,16 +3431,7 @@ func IccinfochangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("iccinfochange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4051,16 +3459,7 @@ func IncomingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("incoming")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4088,20 +3487,22 @@ func InputEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("input")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnInput returns a InputEvent whose callback receives the event as a
+// *eventx.InputEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.InputEvent are not delivered to the callback.
+func OnInput(callback func(*eventx.InputEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return InputEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.InputEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // InvalidEvent Documentation is as below: "A submittable element has been checked and doesn't satisfy its constraints."
 // https://developer.mozilla.org/docs/Web/Events/invalid
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4125,16 +3526,7 @@ func InvalidEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("invalid")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4159,23 +3551,25 @@ func KeyDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("KeyDown")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("keydown")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnKeyDown returns a KeyDownEvent whose callback receives the event as a
+// *eventx.KeyboardEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.KeyboardEvent are not delivered to the callback.
+func OnKeyDown(callback func(*eventx.KeyboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return KeyDownEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.KeyboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // KeyPressEvent Documentation is as below: "A key is pressed down and that key normally produces a character value (use input instead)."
 // https://developer.mozilla.org/docs/Web/Events/keypress
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4196,23 +3590,25 @@ func KeyPressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("KeyPress")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("keypress")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnKeyPress returns a KeyPressEvent whose callback receives the event as a
+// *eventx.KeyboardEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.KeyboardEvent are not delivered to the callback.
+func OnKeyPress(callback func(*eventx.KeyboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return KeyPressEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.KeyboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // KeyUpEvent Documentation is as below: "A key is released."
 // https://developer.mozilla.org/docs/Web/Events/keyup
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4233,23 +3629,25 @@ func KeyUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("KeyUp")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("keyup")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnKeyUp returns a KeyUpEvent whose callback receives the event as a
+// *eventx.KeyboardEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.KeyboardEvent are not delivered to the callback.
+func OnKeyUp(callback func(*eventx.KeyboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return KeyUpEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.KeyboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // LanguageChangeEvent Documentation is as below: "The user's preferred languages have changed."
 // https://developer.mozilla.org/docs/Web/Events/languagechange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4270,19 +3668,10 @@ func LanguageChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("LanguageChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("languagechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4307,19 +3696,10 @@ func LevelChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("LevelChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("levelchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4347,16 +3727,7 @@ func LoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("load")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4381,19 +3752,10 @@ func LoadEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("LoadEnd")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("loadend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4418,19 +3780,10 @@ func LoadStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("LoadStart")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("loadstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4455,19 +3808,10 @@ func LoadedDataEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("LoadedData")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("loadeddata")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4492,19 +3836,10 @@ func LoadedMetadataEvent(callback interface{}, options ...trees.EventOptions) *t
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("LoadedMetadata")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("loadedmetadata")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4532,27 +3867,18 @@ func LocalizedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("localized")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// LostpointercaptureEvent Documentation is as below: "Element lost pointer capture."
+// LostPointerCaptureEvent Documentation is as below: "Element lost pointer capture."
 // https://developer.mozilla.org/docs/Web/Events/lostpointercapture
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func LostPointerCaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -4569,18 +3895,27 @@ func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions
 	ops := append([]trees.EventOptions{trees.EventType("lostpointercapture")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// LostpointercaptureEvent returns a LostPointerCaptureEvent.
+//
+// Deprecated: use LostPointerCaptureEvent.
+func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return LostPointerCaptureEvent(callback, options...)
+}
 
-	return ev
+// OnLostPointerCapture returns a LostPointerCaptureEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnLostPointerCapture(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return LostPointerCaptureEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
 // MarkEvent Documentation is as below: "The spoken utterance reaches a named SSML \"mark\" tag."
@@ -4606,16 +3941,7 @@ func MarkEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("mark")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4643,20 +3969,22 @@ func MessageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("message")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMessage returns a MessageEvent whose callback receives the event as a
+// *eventx.MessageEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MessageEvent are not delivered to the callback.
+func OnMessage(callback func(*eventx.MessageEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MessageEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MessageEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseDownEvent Documentation is as below: "A pointing device button (usually a mouse) is pressed on an element."
 // https://developer.mozilla.org/docs/Web/Events/mousedown
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4677,23 +4005,25 @@ func MouseDownEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("MouseDown")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("mousedown")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMouseDown returns a MouseDownEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnMouseDown(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseDownEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseEnterEvent Documentation is as below: "A pointing device is moved onto the element that has the listener attached."
 // https://developer.mozilla.org/docs/Web/Events/mouseenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4714,23 +4044,25 @@ func MouseEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("MouseEnter")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("mouseenter")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMouseEnter returns a MouseEnterEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnMouseEnter(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseEnterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseLeaveEvent Documentation is as below: "A pointing device is moved off the element that has the listener attached."
 // https://developer.mozilla.org/docs/Web/Events/mouseleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4751,23 +4083,25 @@ func MouseLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("MouseLeave")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("mouseleave")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMouseLeave returns a MouseLeaveEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnMouseLeave(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseLeaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseMoveEvent Documentation is as below: "A pointing device is moved over an element."
 // https://developer.mozilla.org/docs/Web/Events/mousemove
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4788,23 +4122,25 @@ func MouseMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("MouseMove")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("mousemove")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMouseMove returns a MouseMoveEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnMouseMove(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseMoveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseOutEvent Documentation is as below: "A pointing device is moved off the element that has the listener attached or off one of its children."
 // https://developer.mozilla.org/docs/Web/Events/mouseout
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4825,23 +4161,25 @@ func MouseOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("MouseOut")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("mouseout")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMouseOut returns a MouseOutEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnMouseOut(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseOutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseOverEvent Documentation is as below: "A pointing device is moved onto the element that has the listener attached or onto one of its children."
 // https://developer.mozilla.org/docs/Web/Events/mouseover
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4862,23 +4200,25 @@ func MouseOverEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("MouseOver")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("mouseover")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMouseOver returns a MouseOverEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnMouseOver(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseOverEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseUpEvent Documentation is as below: "A pointing device button is released over an element."
 // https://developer.mozilla.org/docs/Web/Events/mouseup
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4899,23 +4239,25 @@ func MouseUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("MouseUp")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("mouseup")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnMouseUp returns a MouseUpEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnMouseUp(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseUpEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MozAfterPaintEvent Documentation is as below: "Content has been repainted."
 // https://developer.mozilla.org/docs/Web/Reference/Events/MozAfterPaint
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4939,16 +4281,7 @@ func MozAfterPaintEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("MozAfterPaint")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -4976,16 +4309,7 @@ func MozAudioAvailableEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("MozAudioAvailable")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5013,16 +4337,7 @@ func MozBeforeResizeEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("MozBeforeResize")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5050,16 +4365,7 @@ func MozEdgeUIGestureEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("MozEdgeUIGesture")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5087,16 +4393,7 @@ func MozEnteredDomFullscreenEvent(callback interface{}, options ...trees.EventOp
 	ops := append([]trees.EventOptions{trees.EventType("MozEnteredDomFullscreen")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5124,16 +4421,7 @@ func MozGamepadButtonDownEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("MozGamepadButtonDown")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5161,16 +4449,7 @@ func MozGamepadButtonUpEvent(callback interface{}, options ...trees.EventOptions
 	ops := append([]trees.EventOptions{trees.EventType("MozGamepadButtonUp")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5198,16 +4477,7 @@ func MozMagnifyGestureEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("MozMagnifyGesture")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5235,16 +4505,7 @@ func MozMagnifyGestureStartEvent(callback interface{}, options ...trees.EventOpt
 	ops := append([]trees.EventOptions{trees.EventType("MozMagnifyGestureStart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5272,16 +4533,7 @@ func MozMagnifyGestureUpdateEvent(callback interface{}, options ...trees.EventOp
 	ops := append([]trees.EventOptions{trees.EventType("MozMagnifyGestureUpdate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5309,16 +4561,7 @@ func MozPressTapGestureEvent(callback interface{}, options ...trees.EventOptions
 	ops := append([]trees.EventOptions{trees.EventType("MozPressTapGesture")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5346,16 +4589,7 @@ func MozRotateGestureEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("MozRotateGesture")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5383,16 +4617,7 @@ func MozRotateGestureStartEvent(callback interface{}, options ...trees.EventOpti
 	ops := append([]trees.EventOptions{trees.EventType("MozRotateGestureStart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5420,16 +4645,7 @@ func MozRotateGestureUpdateEvent(callback interface{}, options ...trees.EventOpt
 	ops := append([]trees.EventOptions{trees.EventType("MozRotateGestureUpdate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5457,16 +4673,7 @@ func MozScrolledAreaChangedEvent(callback interface{}, options ...trees.EventOpt
 	ops := append([]trees.EventOptions{trees.EventType("MozScrolledAreaChanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5494,16 +4701,7 @@ func MozSwipeGestureEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("MozSwipeGesture")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5531,16 +4729,7 @@ func MozTapGestureEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("MozTapGesture")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5568,16 +4757,7 @@ func MozbrowseractivitydoneEvent(callback interface{}, options ...trees.EventOpt
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowseractivitydone")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5605,16 +4785,7 @@ func MozbrowserasyncscrollEvent(callback interface{}, options ...trees.EventOpti
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserasyncscroll")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5642,16 +4813,7 @@ func MozbrowseraudioplaybackchangeEvent(callback interface{}, options ...trees.E
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowseraudioplaybackchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5679,16 +4841,7 @@ func MozbrowsercaretstatechangedEvent(callback interface{}, options ...trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsercaretstatechanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5716,16 +4869,7 @@ func MozbrowsercloseEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserclose")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5753,16 +4897,7 @@ func MozbrowsercontextmenuEvent(callback interface{}, options ...trees.EventOpti
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsercontextmenu")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5790,16 +4925,7 @@ func MozbrowserdocumentfirstpaintEvent(callback interface{}, options ...trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserdocumentfirstpaint")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5827,16 +4953,7 @@ func MozbrowsererrorEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsererror")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5864,16 +4981,7 @@ func MozbrowserfindchangeEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserfindchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5901,16 +5009,7 @@ func MozbrowserfirstpaintEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserfirstpaint")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5938,16 +5037,7 @@ func MozbrowsericonchangeEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsericonchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -5975,16 +5065,7 @@ func MozbrowserloadendEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserloadend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6012,16 +5093,7 @@ func MozbrowserloadstartEvent(callback interface{}, options ...trees.EventOption
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserloadstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6049,16 +5121,7 @@ func MozbrowserlocationchangeEvent(callback interface{}, options ...trees.EventO
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserlocationchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6086,16 +5149,7 @@ func MozbrowsermanifestchangeEvent(callback interface{}, options ...trees.EventO
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsermanifestchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6123,16 +5177,7 @@ func MozbrowsermetachangeEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsermetachange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6160,16 +5205,7 @@ func MozbrowseropensearchEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowseropensearch")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6197,16 +5233,7 @@ func MozbrowseropentabEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowseropentab")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6234,16 +5261,7 @@ func MozbrowseropenwindowEvent(callback interface{}, options ...trees.EventOptio
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowseropenwindow")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6271,16 +5289,7 @@ func MozbrowserresizeEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserresize")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6308,16 +5317,7 @@ func MozbrowserscrollEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserscroll")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6345,16 +5345,7 @@ func MozbrowserscrollareachangedEvent(callback interface{}, options ...trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserscrollareachanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6382,16 +5373,7 @@ func MozbrowserscrollviewchangeEvent(callback interface{}, options ...trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserscrollviewchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6419,16 +5401,7 @@ func MozbrowsersecuritychangeEvent(callback interface{}, options ...trees.EventO
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsersecuritychange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6456,16 +5429,7 @@ func MozbrowserselectionstatechangedEvent(callback interface{}, options ...trees
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserselectionstatechanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6493,16 +5457,7 @@ func MozbrowsershowmodalpromptEvent(callback interface{}, options ...trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsershowmodalprompt")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6530,16 +5485,7 @@ func MozbrowsertitlechangeEvent(callback interface{}, options ...trees.EventOpti
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowsertitlechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6567,16 +5513,7 @@ func MozbrowserusernameandpasswordrequiredEvent(callback interface{}, options ..
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowserusernameandpasswordrequired")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6604,16 +5541,7 @@ func MozbrowservisibilitychangeEvent(callback interface{}, options ...trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("mozbrowservisibilitychange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6641,16 +5569,7 @@ func MoztimechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("moztimechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6675,19 +5594,10 @@ func NoUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("NoUpdate")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("noupdate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6715,16 +5625,7 @@ func NomatchEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("nomatch")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6752,16 +5653,7 @@ func NotificationclickEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("notificationclick")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6789,16 +5681,7 @@ func ObsoleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("obsolete")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6826,16 +5709,7 @@ func OfflineEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("offline")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6863,16 +5737,7 @@ func OnconnectedEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("onconnected")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6900,16 +5765,7 @@ func OnlineEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("online")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6937,16 +5793,7 @@ func OpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("open")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -6971,19 +5818,10 @@ func OrientationChangeEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("OrientationChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("orientationchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7011,16 +5849,7 @@ func OverflowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("overflow")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7045,23 +5874,25 @@ func PageHideEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("PageHide")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pagehide")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnPageHide returns a PageHideEvent whose callback receives the event as a
+// *eventx.PageTransitionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PageTransitionEvent are not delivered to the callback.
+func OnPageHide(callback func(*eventx.PageTransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PageHideEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PageTransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PageShowEvent Documentation is as below: "A session history entry is being traversed to."
 // https://developer.mozilla.org/docs/Web/Events/pageshow
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7082,23 +5913,25 @@ func PageShowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("PageShow")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pageshow")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnPageShow returns a PageShowEvent whose callback receives the event as a
+// *eventx.PageTransitionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PageTransitionEvent are not delivered to the callback.
+func OnPageShow(callback func(*eventx.PageTransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PageShowEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PageTransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PasteEvent Documentation is as below: "Data has been transferred from the system clipboard to the document."
 // https://developer.mozilla.org/docs/Web/Events/paste
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7122,20 +5955,22 @@ func PasteEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("paste")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnPaste returns a PasteEvent whose callback receives the event as a
+// *eventx.ClipboardEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.ClipboardEvent are not delivered to the callback.
+func OnPaste(callback func(*eventx.ClipboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PasteEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ClipboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PauseEvent Documentation is as below: "The utterance is paused part way through."
 // https://developer.mozilla.org/docs/Web/Events/pause_(SpeechSynthesis)
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7159,16 +5994,7 @@ func PauseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("pause")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7196,16 +6022,7 @@ func PlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("play")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7233,27 +6050,18 @@ func PlayingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("playing")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// PointerLockChangeEvent Documentation is as below: "The pointer was locked or released."
-// https://developer.mozilla.org/docs/Web/Events/pointerlockchange
+// PointerCancelEvent Documentation is as below: "The pointer is unlikely to produce any more events."
+// https://developer.mozilla.org/docs/Web/Events/pointercancel
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerCancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7267,30 +6075,39 @@ func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("PointerLockChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pointercancel")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointercancelEvent returns a PointerCancelEvent.
+//
+// Deprecated: use PointerCancelEvent.
+func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerCancelEvent(callback, options...)
+}
 
-	return ev
+// OnPointerCancel returns a PointerCancelEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerCancel(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerCancelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// PointerLockErrorEvent Documentation is as below: "It was impossible to lock the pointer for technical reasons or because the permission was denied."
-// https://developer.mozilla.org/docs/Web/Events/pointerlockerror
+// PointerDownEvent Documentation is as below: "The pointer enters the active buttons state."
+// https://developer.mozilla.org/docs/Web/Events/pointerdown
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7304,30 +6121,39 @@ func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("PointerLockError")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pointerdown")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointerdownEvent returns a PointerDownEvent.
+//
+// Deprecated: use PointerDownEvent.
+func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerDownEvent(callback, options...)
+}
 
-	return ev
+// OnPointerDown returns a PointerDownEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerDown(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerDownEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// PointercancelEvent Documentation is as below: "The pointer is unlikely to produce any more events."
-// https://developer.mozilla.org/docs/Web/Events/pointercancel
+// PointerEnterEvent Documentation is as below: "Pointing device is moved inside the hit-testing boundary."
+// https://developer.mozilla.org/docs/Web/Events/pointerenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7341,30 +6167,39 @@ func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *tr
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("pointercancel")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pointerenter")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointerenterEvent returns a PointerEnterEvent.
+//
+// Deprecated: use PointerEnterEvent.
+func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerEnterEvent(callback, options...)
+}
 
-	return ev
+// OnPointerEnter returns a PointerEnterEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerEnter(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerEnterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// PointerdownEvent Documentation is as below: "The pointer enters the active buttons state."
-// https://developer.mozilla.org/docs/Web/Events/pointerdown
+// PointerLeaveEvent Documentation is as below: "Pointing device is moved out of the hit-testing boundary."
+// https://developer.mozilla.org/docs/Web/Events/pointerleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7378,30 +6213,39 @@ func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *tree
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("pointerdown")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pointerleave")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointerleaveEvent returns a PointerLeaveEvent.
+//
+// Deprecated: use PointerLeaveEvent.
+func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerLeaveEvent(callback, options...)
+}
 
-	return ev
+// OnPointerLeave returns a PointerLeaveEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerLeave(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerLeaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// PointerenterEvent Documentation is as below: "Pointing device is moved inside the hit-testing boundary."
-// https://developer.mozilla.org/docs/Web/Events/pointerenter
+// PointerLockChangeEvent Documentation is as below: "The pointer was locked or released."
+// https://developer.mozilla.org/docs/Web/Events/pointerlockchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7415,30 +6259,21 @@ func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *tre
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("pointerenter")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pointerlockchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// PointerleaveEvent Documentation is as below: "Pointing device is moved out of the hit-testing boundary."
-// https://developer.mozilla.org/docs/Web/Events/pointerleave
+// PointerLockErrorEvent Documentation is as below: "It was impossible to lock the pointer for technical reasons or because the permission was denied."
+// https://developer.mozilla.org/docs/Web/Events/pointerlockerror
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7452,30 +6287,21 @@ func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *tre
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("pointerleave")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("pointerlockerror")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// PointermoveEvent Documentation is as below: "The pointer changed coordinates."
+// PointerMoveEvent Documentation is as below: "The pointer changed coordinates."
 // https://developer.mozilla.org/docs/Web/Events/pointermove
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7492,27 +6318,36 @@ func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("pointermove")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointermoveEvent returns a PointerMoveEvent.
+//
+// Deprecated: use PointerMoveEvent.
+func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerMoveEvent(callback, options...)
+}
 
-	return ev
+// OnPointerMove returns a PointerMoveEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerMove(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerMoveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// PointeroutEvent Documentation is as below: "The pointing device moved out of hit-testing boundary or leaves detectable hover range."
+// PointerOutEvent Documentation is as below: "The pointing device moved out of hit-testing boundary or leaves detectable hover range."
 // https://developer.mozilla.org/docs/Web/Events/pointerout
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerOutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7529,27 +6364,36 @@ func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("pointerout")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointeroutEvent returns a PointerOutEvent.
+//
+// Deprecated: use PointerOutEvent.
+func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerOutEvent(callback, options...)
+}
 
-	return ev
+// OnPointerOut returns a PointerOutEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerOut(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerOutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// PointeroverEvent Documentation is as below: "The pointing device is moved into the hit-testing boundary."
+// PointerOverEvent Documentation is as below: "The pointing device is moved into the hit-testing boundary."
 // https://developer.mozilla.org/docs/Web/Events/pointerover
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerOverEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7566,27 +6410,36 @@ func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("pointerover")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointeroverEvent returns a PointerOverEvent.
+//
+// Deprecated: use PointerOverEvent.
+func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerOverEvent(callback, options...)
+}
 
-	return ev
+// OnPointerOver returns a PointerOverEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerOver(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerOverEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// PointerupEvent Documentation is as below: "The pointer leaves the active buttons state."
+// PointerUpEvent Documentation is as below: "The pointer leaves the active buttons state."
 // https://developer.mozilla.org/docs/Web/Events/pointerup
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func PointerUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -7603,18 +6456,27 @@ func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("pointerup")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// PointerupEvent returns a PointerUpEvent.
+//
+// Deprecated: use PointerUpEvent.
+func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return PointerUpEvent(callback, options...)
+}
 
-	return ev
+// OnPointerUp returns a PointerUpEvent whose callback receives the event as a
+// *eventx.PointerEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PointerEvent are not delivered to the callback.
+func OnPointerUp(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerUpEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
 // PopStateEvent Documentation is as below: "A session history entry is being navigated to (in certain cases)."
@@ -7637,23 +6499,25 @@ func PopStateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("PopState")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("popstate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnPopState returns a PopStateEvent whose callback receives the event as a
+// *eventx.PopStateEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.PopStateEvent are not delivered to the callback.
+func OnPopState(callback func(*eventx.PopStateEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PopStateEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PopStateEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PopuphiddenEvent Documentation is as below: "A menupopup, panel or tooltip has been hidden."
 // https://developer.mozilla.org/docs/Web/Events/popuphidden
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7677,16 +6541,7 @@ func PopuphiddenEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("popuphidden")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7714,16 +6569,7 @@ func PopuphidingEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("popuphiding")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7751,16 +6597,7 @@ func PopupshowingEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("popupshowing")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7788,16 +6625,7 @@ func PopupshownEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("popupshown")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7825,16 +6653,7 @@ func ProgressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("progress")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7862,16 +6681,7 @@ func PushEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("push")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7899,16 +6709,7 @@ func PushsubscriptionchangeEvent(callback interface{}, options ...trees.EventOpt
 	ops := append([]trees.EventOptions{trees.EventType("pushsubscriptionchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7936,16 +6737,7 @@ func RadioStateChangeEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("RadioStateChange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -7970,19 +6762,10 @@ func RateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("RateChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("ratechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8007,19 +6790,10 @@ func ReadystateChangeEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("ReadystateChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("readystatechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8047,16 +6821,7 @@ func ReceivedEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("received")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8084,16 +6849,7 @@ func RepeatEventEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("repeatEvent")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8121,16 +6877,7 @@ func RequestprogressEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("requestprogress")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8158,16 +6905,7 @@ func ResetEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("reset")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8195,20 +6933,22 @@ func ResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("resize")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnResize returns a ResizeEvent whose callback receives the event as a
+// *eventx.UIEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.UIEvent are not delivered to the callback.
+func OnResize(callback func(*eventx.UIEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ResizeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UIEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // ResourcetimingbufferfullEvent Documentation is as below: "The browser's resource timing buffer is full."
 // https://developer.mozilla.org/docs/Web/Events/resourcetimingbufferfull
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8232,16 +6972,7 @@ func ResourcetimingbufferfullEvent(callback interface{}, options ...trees.EventO
 	ops := append([]trees.EventOptions{trees.EventType("resourcetimingbufferfull")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8269,16 +7000,7 @@ func ResponseprogressEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("responseprogress")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8306,16 +7028,7 @@ func ResultEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("result")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8343,16 +7056,7 @@ func ResumeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("resume")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8380,16 +7084,7 @@ func ResumingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("resuming")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8417,16 +7112,7 @@ func SSTabClosingEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("SSTabClosing")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8454,16 +7140,7 @@ func SSTabRestoredEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("SSTabRestored")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8491,16 +7168,7 @@ func SSTabRestoringEvent(callback interface{}, options ...trees.EventOptions) *t
 	ops := append([]trees.EventOptions{trees.EventType("SSTabRestoring")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8528,16 +7196,7 @@ func SSWindowClosingEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("SSWindowClosing")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8565,16 +7224,7 @@ func SSWindowStateBusyEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("SSWindowStateBusy")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8602,16 +7252,7 @@ func SSWindowStateReadyEvent(callback interface{}, options ...trees.EventOptions
 	ops := append([]trees.EventOptions{trees.EventType("SSWindowStateReady")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8639,16 +7280,7 @@ func SVGAbortEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("SVGAbort")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8676,16 +7308,7 @@ func SVGErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("SVGError")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8713,16 +7336,7 @@ func SVGLoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("SVGLoad")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8750,16 +7364,7 @@ func SVGResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("SVGResize")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8787,16 +7392,7 @@ func SVGScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("SVGScroll")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8824,16 +7420,7 @@ func SVGUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("SVGUnload")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8861,20 +7448,22 @@ func SVGZoomEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("SVGZoom")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnSVGZoom returns a SVGZoomEvent whose callback receives the event as a
+// *eventx.SVGZoomEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.SVGZoomEvent are not delivered to the callback.
+func OnSVGZoom(callback func(*eventx.SVGZoomEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGZoomEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGZoomEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // ScrollEvent Documentation is as below: "The document view or an element has been scrolled."
 // https://developer.mozilla.org/docs/Web/Events/scroll
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8898,20 +7487,22 @@ func ScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("scroll")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnScroll returns a ScrollEvent whose callback receives the event as a
+// *eventx.UIEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.UIEvent are not delivered to the callback.
+func OnScroll(callback func(*eventx.UIEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ScrollEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UIEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SeekedEvent Documentation is as below: "A seek operation completed."
 // https://developer.mozilla.org/docs/Web/Events/seeked
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8935,16 +7526,7 @@ func SeekedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("seeked")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -8972,16 +7554,7 @@ func SeekingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("seeking")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9009,16 +7582,7 @@ func SelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("select")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9046,16 +7610,7 @@ func SelectionchangeEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("selectionchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9083,16 +7638,7 @@ func SelectstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("selectstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9120,16 +7666,7 @@ func SentEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("sent")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9157,20 +7694,22 @@ func ShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	ops := append([]trees.EventOptions{trees.EventType("show")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnShow returns a ShowEvent whose callback receives the event as a
+// *eventx.MouseEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.MouseEvent are not delivered to the callback.
+func OnShow(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ShowEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SizemodechangeEvent Documentation is as below: "Window has entered/left fullscreen mode, or has been minimized/unminimized."
 // https://developer.mozilla.org/docs/Web/Reference/Events/sizemodechange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -9194,16 +7733,7 @@ func SizemodechangeEvent(callback interface{}, options ...trees.EventOptions) *t
 	ops := append([]trees.EventOptions{trees.EventType("sizemodechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9228,19 +7758,10 @@ func SmartCardInsertEvent(callback interface{}, options ...trees.EventOptions) *
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("SmartCardInsert")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("smartcard-insert")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9265,19 +7786,10 @@ func SmartCardRemoveEvent(callback interface{}, options ...trees.EventOptions) *
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("SmartCardRemove")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("smartcard-remove")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9305,16 +7817,7 @@ func SoundendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("soundend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9342,16 +7845,7 @@ func SoundstartEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("soundstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9379,16 +7873,7 @@ func SpeechendEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("speechend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9416,16 +7901,7 @@ func SpeechstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("speechstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9453,16 +7929,7 @@ func StalledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("stalled")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9489,17 +7956,8 @@ func StartEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 
 	ops := append([]trees.EventOptions{trees.EventType("start")}, options...)
 
-	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9527,16 +7985,7 @@ func StatechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("statechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9564,16 +8013,7 @@ func StatuschangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("statuschange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9601,16 +8041,7 @@ func StkcommandEvent(callback interface{}, options ...trees.EventOptions) *trees
 	ops := append([]trees.EventOptions{trees.EventType("stkcommand")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9638,16 +8069,7 @@ func StksessionendEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("stksessionend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9675,20 +8097,22 @@ func StorageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("storage")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnStorage returns a StorageEvent whose callback receives the event as a
+// *eventx.StorageEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.StorageEvent are not delivered to the callback.
+func OnStorage(callback func(*eventx.StorageEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return StorageEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.StorageEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SubmitEvent Documentation is as below: "A form is submitted."
 // https://developer.mozilla.org/docs/Web/Events/submit
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -9712,20 +8136,22 @@ func SubmitEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("submit")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnSubmit returns a SubmitEvent whose callback receives the event as a
+// *eventx.SubmitEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.SubmitEvent are not delivered to the callback.
+func OnSubmit(callback func(*eventx.SubmitEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SubmitEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SubmitEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SuccessEvent Documentation is as below: "A request successfully completed."
 // https://developer.mozilla.org/docs/Web/Reference/Events/success_indexedDB
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -9749,16 +8175,7 @@ func SuccessEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("success")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9786,16 +8203,7 @@ func SuspendEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("suspend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9823,16 +8231,7 @@ func TabCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	ops := append([]trees.EventOptions{trees.EventType("TabClose")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9860,16 +8259,7 @@ func TabHideEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("TabHide")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9897,16 +8287,7 @@ func TabOpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("TabOpen")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9934,16 +8315,7 @@ func TabPinnedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("TabPinned")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -9971,16 +8343,7 @@ func TabSelectEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("TabSelect")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10008,16 +8371,7 @@ func TabShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("TabShow")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10045,16 +8399,7 @@ func TabUnpinnedEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("TabUnpinned")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10079,19 +8424,10 @@ func TimeUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TimeUpdate")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("timeupdate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10119,16 +8455,7 @@ func TimeoutEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("timeout")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10153,23 +8480,25 @@ func TouchCancelEvent(callback interface{}, options ...trees.EventOptions) *tree
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TouchCancel")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("touchcancel")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnTouchCancel returns a TouchCancelEvent whose callback receives the event as a
+// *eventx.TouchEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TouchEvent are not delivered to the callback.
+func OnTouchCancel(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchCancelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchEndEvent Documentation is as below: "A touch point is removed from the touch surface."
 // https://developer.mozilla.org/docs/Web/Events/touchend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10190,23 +8519,25 @@ func TouchEndEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TouchEnd")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("touchend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnTouchEnd returns a TouchEndEvent whose callback receives the event as a
+// *eventx.TouchEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TouchEvent are not delivered to the callback.
+func OnTouchEnd(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchEnterEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/touchenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10227,23 +8558,25 @@ func TouchEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TouchEnter")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("touchenter")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnTouchEnter returns a TouchEnterEvent whose callback receives the event as a
+// *eventx.TouchEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TouchEvent are not delivered to the callback.
+func OnTouchEnter(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchEnterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchLeaveEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/touchleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10264,23 +8597,25 @@ func TouchLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TouchLeave")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("touchleave")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnTouchLeave returns a TouchLeaveEvent whose callback receives the event as a
+// *eventx.TouchEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TouchEvent are not delivered to the callback.
+func OnTouchLeave(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchLeaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchMoveEvent Documentation is as below: "A touch point is moved along the touch surface."
 // https://developer.mozilla.org/docs/Web/Events/touchmove
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10301,23 +8636,25 @@ func TouchMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TouchMove")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("touchmove")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnTouchMove returns a TouchMoveEvent whose callback receives the event as a
+// *eventx.TouchEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TouchEvent are not delivered to the callback.
+func OnTouchMove(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchMoveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchStartEvent Documentation is as below: "A touch point is placed on the touch surface."
 // https://developer.mozilla.org/docs/Web/Events/touchstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10338,30 +8675,32 @@ func TouchStartEvent(callback interface{}, options ...trees.EventOptions) *trees
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TouchStart")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("touchstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// TransitionEndEvent Documentation is as below: "A CSS transition has completed."
-// https://developer.mozilla.org/docs/Web/Events/transitionend
+// OnTouchStart returns a TouchStartEvent whose callback receives the event as a
+// *eventx.TouchEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TouchEvent are not delivered to the callback.
+func OnTouchStart(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
+// TransitionCancelEvent Documentation is as below: "(no documentation)"
+// https://developer.mozilla.org/docs/Web/Events/transitioncancel
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func TransitionCancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -10375,30 +8714,39 @@ func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *tr
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("TransitionEnd")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("transitioncancel")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// TransitioncancelEvent returns a TransitionCancelEvent.
+//
+// Deprecated: use TransitionCancelEvent.
+func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return TransitionCancelEvent(callback, options...)
+}
 
-	return ev
+// OnTransitionCancel returns a TransitionCancelEvent whose callback receives the event as a
+// *eventx.TransitionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TransitionEvent are not delivered to the callback.
+func OnTransitionCancel(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitionCancelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// TransitioncancelEvent Documentation is as below: "(no documentation)"
-// https://developer.mozilla.org/docs/Web/Events/transitioncancel
+// TransitionEndEvent Documentation is as below: "A CSS transition has completed."
+// https://developer.mozilla.org/docs/Web/Events/transitionend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -10412,30 +8760,32 @@ func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("transitioncancel")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("transitionend")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
-// TransitionrunEvent Documentation is as below: "(no documentation)"
+// OnTransitionEnd returns a TransitionEndEvent whose callback receives the event as a
+// *eventx.TransitionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TransitionEvent are not delivered to the callback.
+func OnTransitionEnd(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitionEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
+// TransitionRunEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/transitionrun
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func TransitionRunEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -10452,27 +8802,36 @@ func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("transitionrun")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// TransitionrunEvent returns a TransitionRunEvent.
+//
+// Deprecated: use TransitionRunEvent.
+func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return TransitionRunEvent(callback, options...)
+}
 
-	return ev
+// OnTransitionRun returns a TransitionRunEvent whose callback receives the event as a
+// *eventx.TransitionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TransitionEvent are not delivered to the callback.
+func OnTransitionRun(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitionRunEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
-// TransitionstartEvent Documentation is as below: "(no documentation)"
+// TransitionStartEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/transitionstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
 // mechanism of the domtrees.Element i.e if the selectorOverride argument is an empty string then domtrees.Element will create an
 // appropriate selector matching its type and uid value in this format  (ElementType[uid='UID_VALUE']) but if
 // the selector value is not empty then that becomes the default selector used match the event with.
-func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+func TransitionStartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	var handler EventHandler
 
 	switch cb := callback.(type) {
@@ -10489,18 +8848,27 @@ func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *
 	ops := append([]trees.EventOptions{trees.EventType("transitionstart")}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
+	return ev
+}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+// TransitionstartEvent returns a TransitionStartEvent.
+//
+// Deprecated: use TransitionStartEvent.
+func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return TransitionStartEvent(callback, options...)
+}
 
-	return ev
+// OnTransitionStart returns a TransitionStartEvent whose callback receives the event as a
+// *eventx.TransitionEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.TransitionEvent are not delivered to the callback.
+func OnTransitionStart(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitionStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
 }
 
 // UnderflowEvent Documentation is as below: "An element is no longer overflowed by its content (only works for elements styled with overflow != visible)."
@@ -10526,16 +8894,7 @@ func UnderflowEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	ops := append([]trees.EventOptions{trees.EventType("underflow")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10563,16 +8922,7 @@ func UnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	ops := append([]trees.EventOptions{trees.EventType("unload")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10597,19 +8947,10 @@ func UpdateReadyEvent(callback interface{}, options ...trees.EventOptions) *tree
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("UpdateReady")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("updateready")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10634,23 +8975,25 @@ func UpgradeNeededEvent(callback interface{}, options ...trees.EventOptions) *tr
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("UpgradeNeeded")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("upgradeneeded")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnUpgradeNeeded returns a UpgradeNeededEvent whose callback receives the event as a
+// *eventx.IDBVersionChangeEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.IDBVersionChangeEvent are not delivered to the callback.
+func OnUpgradeNeeded(callback func(*eventx.IDBVersionChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return UpgradeNeededEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.IDBVersionChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // UserProximityEvent Documentation is as below: "Fresh data is available from a proximity sensor (indicates whether the nearby object is near the device or not)."
 // https://developer.mozilla.org/docs/Web/Events/userproximity
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10671,23 +9014,25 @@ func UserProximityEvent(callback interface{}, options ...trees.EventOptions) *tr
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("UserProximity")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("userproximity")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnUserProximity returns a UserProximityEvent whose callback receives the event as a
+// *eventx.UserProximityEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.UserProximityEvent are not delivered to the callback.
+func OnUserProximity(callback func(*eventx.UserProximityEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return UserProximityEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UserProximityEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // UssdreceivedEvent Documentation is as below: "A new USSD message is received"
 // https://developer.mozilla.org/docs/Web/Events/ussdreceived
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10711,16 +9056,7 @@ func UssdreceivedEvent(callback interface{}, options ...trees.EventOptions) *tre
 	ops := append([]trees.EventOptions{trees.EventType("ussdreceived")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10748,16 +9084,7 @@ func ValueChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("ValueChange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10782,23 +9109,25 @@ func VersionChangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("VersionChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("versionchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
 
+// OnVersionChange returns a VersionChangeEvent whose callback receives the event as a
+// *eventx.IDBVersionChangeEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.IDBVersionChangeEvent are not delivered to the callback.
+func OnVersionChange(callback func(*eventx.IDBVersionChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return VersionChangeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.IDBVersionChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // VisibilityChangeEvent Documentation is as below: "The content of a tab has become visible or has been hidden."
 // https://developer.mozilla.org/docs/Web/Events/visibilitychange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10819,19 +9148,10 @@ func VisibilityChangeEvent(callback interface{}, options ...trees.EventOptions)
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("VisibilityChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("visibilitychange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10859,16 +9179,7 @@ func VoicechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 	ops := append([]trees.EventOptions{trees.EventType("voicechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10896,16 +9207,7 @@ func VoiceschangedEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("voiceschanged")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10930,19 +9232,10 @@ func VolumeChangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 		panic("Unacceptable type for event callback")
 	}
 
-	ops := append([]trees.EventOptions{trees.EventType("VolumeChange")}, options...)
+	ops := append([]trees.EventOptions{trees.EventType("volumechange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -10970,16 +9263,7 @@ func VrdisplayactivateEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("vrdisplayactivate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11007,16 +9291,7 @@ func VrdisplayblurEvent(callback interface{}, options ...trees.EventOptions) *tr
 	ops := append([]trees.EventOptions{trees.EventType("vrdisplayblur")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11044,16 +9319,7 @@ func VrdisplayconnectEvent(callback interface{}, options ...trees.EventOptions)
 	ops := append([]trees.EventOptions{trees.EventType("vrdisplayconnect")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11081,16 +9347,7 @@ func VrdisplaydeactivateEvent(callback interface{}, options ...trees.EventOption
 	ops := append([]trees.EventOptions{trees.EventType("vrdisplaydeactivate")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11118,16 +9375,7 @@ func VrdisplaydisconnectEvent(callback interface{}, options ...trees.EventOption
 	ops := append([]trees.EventOptions{trees.EventType("vrdisplaydisconnect")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11155,16 +9403,7 @@ func VrdisplayfocusEvent(callback interface{}, options ...trees.EventOptions) *t
 	ops := append([]trees.EventOptions{trees.EventType("vrdisplayfocus")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11192,16 +9431,7 @@ func VrdisplaypresentchangeEvent(callback interface{}, options ...trees.EventOpt
 	ops := append([]trees.EventOptions{trees.EventType("vrdisplaypresentchange")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11229,16 +9459,7 @@ func WaitingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	ops := append([]trees.EventOptions{trees.EventType("waiting")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+	ev.Handler = handler
 
 	return ev
 }
@@ -11266,16 +9487,101 @@ func WheelEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	ops := append([]trees.EventOptions{trees.EventType("wheel")}, options...)
 
 	ev := trees.NewEvent(ops...)
-
-	eventHandler := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
-		if ev.ID() != evm.EventID {
-			return
-		}
-
-		handler(evm.Event, ev.Tree)
-	})
-
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
-
-	return ev
+	ev.Handler = handler
+
+	return ev
+}
+
+// OnWheel returns a WheelEvent whose callback receives the event as a
+// *eventx.WheelEvent, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.WheelEvent are not delivered to the callback.
+func OnWheel(callback func(*eventx.WheelEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return WheelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.WheelEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
+// EventStructs maps the types of the events with typed handlers to the name of
+// the eventx struct their values are decoded into.
+var EventStructs = map[string]string{
+	"animationend":        "AnimationEvent",
+	"animationiteration":  "AnimationEvent",
+	"animationstart":      "AnimationEvent",
+	"auxclick":            "MouseEvent",
+	"beforeunload":        "BeforeUnloadEvent",
+	"blur":                "FocusEvent",
+	"change":              "ChangeEvent",
+	"click":               "MouseEvent",
+	"compositionend":      "CompositionEvent",
+	"compositionstart":    "CompositionEvent",
+	"compositionupdate":   "CompositionEvent",
+	"contextmenu":         "MouseEvent",
+	"copy":                "ClipboardEvent",
+	"cut":                 "ClipboardEvent",
+	"dblclick":            "MouseEvent",
+	"devicelight":         "DeviceLightEvent",
+	"devicemotion":        "DeviceMotionEvent",
+	"deviceorientation":   "DeviceOrientationEvent",
+	"deviceproximity":     "DeviceProximityEvent",
+	"drag":                "DragEvent",
+	"dragend":             "DragEndEvent",
+	"dragenter":           "DragEnterEvent",
+	"dragleave":           "DragLeaveEvent",
+	"dragover":            "DragOverEvent",
+	"dragstart":           "DragStartEvent",
+	"drop":                "DropEvent",
+	"focus":               "FocusEvent",
+	"focusin":             "FocusEvent",
+	"focusout":            "FocusEvent",
+	"gamepadconnected":    "GamepadEvent",
+	"gamepaddisconnected": "GamepadEvent",
+	"gotpointercapture":   "PointerEvent",
+	"hashchange":          "HashChangeEvent",
+	"input":               "InputEvent",
+	"keydown":             "KeyboardEvent",
+	"keypress":            "KeyboardEvent",
+	"keyup":               "KeyboardEvent",
+	"lostpointercapture":  "PointerEvent",
+	"message":             "MessageEvent",
+	"mousedown":           "MouseEvent",
+	"mouseenter":          "MouseEvent",
+	"mouseleave":          "MouseEvent",
+	"mousemove":           "MouseEvent",
+	"mouseout":            "MouseEvent",
+	"mouseover":           "MouseEvent",
+	"mouseup":             "MouseEvent",
+	"pagehide":            "PageTransitionEvent",
+	"pageshow":            "PageTransitionEvent",
+	"paste":               "ClipboardEvent",
+	"pointercancel":       "PointerEvent",
+	"pointerdown":         "PointerEvent",
+	"pointerenter":        "PointerEvent",
+	"pointerleave":        "PointerEvent",
+	"pointermove":         "PointerEvent",
+	"pointerout":          "PointerEvent",
+	"pointerover":         "PointerEvent",
+	"pointerup":           "PointerEvent",
+	"popstate":            "PopStateEvent",
+	"resize":              "UIEvent",
+	"SVGZoom":             "SVGZoomEvent",
+	"scroll":              "UIEvent",
+	"show":                "MouseEvent",
+	"storage":             "StorageEvent",
+	"submit":              "SubmitEvent",
+	"touchcancel":         "TouchEvent",
+	"touchend":            "TouchEvent",
+	"touchenter":          "TouchEvent",
+	"touchleave":          "TouchEvent",
+	"touchmove":           "TouchEvent",
+	"touchstart":          "TouchEvent",
+	"transitioncancel":    "TransitionEvent",
+	"transitionend":       "TransitionEvent",
+	"transitionrun":       "TransitionEvent",
+	"transitionstart":     "TransitionEvent",
+	"upgradeneeded":       "IDBVersionChangeEvent",
+	"userproximity":       "UserProximityEvent",
+	"versionchange":       "IDBVersionChangeEvent",
+	"wheel":               "WheelEvent",
 }
@@ -0,0 +1,54 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/influx6/faux/tests"
+)
+
+// TestEventTypes validates the events are created with the name of their DOM
+// event as type, not the name of their function.
+func TestEventTypes(t *testing.T) {
+	types := []struct {
+		event *trees.Event
+		want  string
+	}{
+		{events.KeyDownEvent(func() {}), "keydown"},
+		{events.DblClickEvent(func() {}), "dblclick"},
+		{events.ClickEvent(func() {}), "click"},
+		{events.CSSRuleViewChangeEvent(func() {}), "CssRuleViewChanged"},
+		{events.SmartCardInsertEvent(func() {}), "smartcard-insert"},
+		{events.OnPointerDown(nil), "pointerdown"},
+		{events.OnGotPointerCapture(nil), "gotpointercapture"},
+		{events.OnAuxClick(nil), "auxclick"},
+	}
+
+	for _, item := range types {
+		if item.event.Type != item.want {
+			tests.Failed("Should have created the event with type %q: %q", item.want, item.event.Type)
+		}
+	}
+	tests.Passed("Should have created the events with the type of their DOM event")
+}
+
+// TestDeprecatedEvents validates the functions kept for the events whose
+// functions were renamed create the same events.
+func TestDeprecatedEvents(t *testing.T) {
+	renamed := []struct {
+		old, current *trees.Event
+	}{
+		{events.AuxclickEvent(func() {}), events.AuxClickEvent(func() {})},
+		{events.PointerdownEvent(func() {}), events.PointerDownEvent(func() {})},
+		{events.LostpointercaptureEvent(func() {}), events.LostPointerCaptureEvent(func() {})},
+		{events.TransitionstartEvent(func() {}), events.TransitionStartEvent(func() {})},
+	}
+
+	for _, item := range renamed {
+		if item.old.Type != item.current.Type {
+			tests.Failed("Should have created a %q event: %q", item.current.Type, item.old.Type)
+		}
+	}
+	tests.Passed("Should have created the same events with the deprecated functions")
+}
@@ -0,0 +1,70 @@
+// +build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// reference defines a row of the event reference stored in reference.json.
+type reference struct {
+	Name        string `json:"name"`
+	Link        string `json:"link"`
+	Interface   string `json:"interface"`
+	Description string `json:"description"`
+}
+
+// fetch refreshes reference.json from the "Event reference" of the Mozilla
+// Developer Network, used by generate.go to generate the events.
+func main() {
+	doc, err := goquery.NewDocument("https://developer.mozilla.org/en-US/docs/Web/Events")
+	if err != nil {
+		panic(err)
+	}
+
+	var rows []reference
+	seen := make(map[string]int)
+
+	doc.Find(".standard-table").Each(func(ind int, item *goquery.Selection) {
+		item.Eq(0).Find("tr").Each(func(i int, s *goquery.Selection) {
+			cols := s.Find("td")
+			if cols.Length() == 0 || cols.Find(".icon-thumbs-down-alt").Length() != 0 {
+				return
+			}
+
+			link := cols.Eq(0).Find("a").Eq(0)
+
+			var row reference
+			row.Name = link.Text()
+			row.Link, _ = link.Attr("href")
+			row.Interface = strings.TrimSpace(cols.Eq(1).Text())
+			row.Description = strings.TrimSpace(cols.Eq(3).Text())
+
+			if row.Name == "" {
+				return
+			}
+
+			// Events listed more than once keep their last row.
+			if index, ok := seen[row.Name]; ok {
+				rows[index] = row
+				return
+			}
+
+			seen[row.Name] = len(rows)
+			rows = append(rows, row)
+		})
+	})
+
+	data, err := json.MarshalIndent(rows, "", "\t")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile("reference.json", append(data, '\n'), 0644); err != nil {
+		panic(err)
+	}
+}
@@ -2,21 +2,38 @@
 
 // The generation of this package was inspired by Neelance work on DOM (https://github.com/neelance/dom)
 
+// The events are generated from reference.json, a snapshot of the tables of the
+// "Event reference" of the Mozilla Developer Network, allowing the generation
+// to run offline. The snapshot is refreshed with "go run fetch.go".
+
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"sort"
 	"strings"
 
-	"github.com/PuerkitoBio/goquery"
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/eventx"
 )
 
 type event struct {
-	Name string
-	Link string
-	Desc string
+	Name   string
+	Type   string
+	Link   string
+	Desc   string
+	Struct string
+}
+
+// reference defines a row of the event reference stored in reference.json.
+type reference struct {
+	Name        string `json:"name"`
+	Link        string `json:"link"`
+	Interface   string `json:"interface"`
+	Description string `json:"description"`
 }
 
 func main() {
@@ -30,6 +47,7 @@ func main() {
 		"animationiteration":        "AnimationIteration",
 		"animationstart":            "AnimationStart",
 		"audioprocess":              "AudioProcess",
+		"auxclick":                  "AuxClick",
 		"beforeprint":               "BeforePrint",
 		"beforeunload":              "BeforeUnload",
 		"canplay":                   "CanPlay",
@@ -59,6 +77,7 @@ func main() {
 		"fullscreenerror":           "FullScreenError",
 		"gamepadconnected":          "GamepadConnected",
 		"gamepaddisconnected":       "GamepadDisconnected",
+		"gotpointercapture":         "GotPointerCapture",
 		"hashchange":                "HashChange",
 		"beforescriptexecute":       "BeforeScriptExecute",
 		"beforeinstallprompt":       "BeforeInstallPrompt",
@@ -71,6 +90,7 @@ func main() {
 		"loadedmetadata":            "LoadedMetadata",
 		"loadend":                   "LoadEnd",
 		"loadstart":                 "LoadStart",
+		"lostpointercapture":        "LostPointerCapture",
 		"mousedown":                 "MouseDown",
 		"mouseenter":                "MouseEnter",
 		"mouseleave":                "MouseLeave",
@@ -82,6 +102,14 @@ func main() {
 		"orientationchange":         "OrientationChange",
 		"pagehide":                  "PageHide",
 		"pageshow":                  "PageShow",
+		"pointercancel":             "PointerCancel",
+		"pointerdown":               "PointerDown",
+		"pointerenter":              "PointerEnter",
+		"pointerleave":              "PointerLeave",
+		"pointermove":               "PointerMove",
+		"pointerout":                "PointerOut",
+		"pointerover":               "PointerOver",
+		"pointerup":                 "PointerUp",
 		"smartcard-remove":          "SmartCardRemove",
 		"smartcard-insert":          "SmartCardInsert",
 		"pointerlockchange":         "PointerLockChange",
@@ -96,7 +124,10 @@ func main() {
 		"touchleave":                "TouchLeave",
 		"touchmove":                 "TouchMove",
 		"touchstart":                "TouchStart",
+		"transitioncancel":          "TransitionCancel",
 		"transitionend":             "TransitionEnd",
+		"transitionrun":             "TransitionRun",
+		"transitionstart":           "TransitionStart",
 		"updateready":               "UpdateReady",
 		"upgradeneeded":             "UpgradeNeeded",
 		"userproximity":             "UserProximity",
@@ -105,61 +136,89 @@ func main() {
 		"volumechange":              "VolumeChange",
 	}
 
+	// structMap maps the events whose values are decoded into a more specific
+	// struct than the interface listed for them by the reference.
+	structMap := map[string]string{
+		"change":    "ChangeEvent",
+		"dragend":   "DragEndEvent",
+		"dragenter": "DragEnterEvent",
+		"dragleave": "DragLeaveEvent",
+		"dragover":  "DragOverEvent",
+		"dragstart": "DragStartEvent",
+		"drop":      "DropEvent",
+		"submit":    "SubmitEvent",
+	}
+
 	ignore := map[string]bool{
 		"error": true,
 	}
 
-	doc, err := goquery.NewDocument("https://developer.mozilla.org/en-US/docs/Web/Events")
+	// renamed lists the events whose functions were named after their
+	// lowercase type before being added to nameMap, for which the old
+	// functions are kept as deprecated aliases.
+	renamed := map[string]bool{
+		"auxclick":           true,
+		"gotpointercapture":  true,
+		"lostpointercapture": true,
+		"pointercancel":      true,
+		"pointerdown":        true,
+		"pointerenter":       true,
+		"pointerleave":       true,
+		"pointermove":        true,
+		"pointerout":         true,
+		"pointerover":        true,
+		"pointerup":          true,
+		"transitioncancel":   true,
+		"transitionrun":      true,
+		"transitionstart":    true,
+	}
+
+	data, err := ioutil.ReadFile("reference.json")
 	if err != nil {
 		panic(err)
 	}
 
-	events := make(map[string]*event)
+	var rows []reference
+	if err := json.Unmarshal(data, &rows); err != nil {
+		panic(err)
+	}
 
-	tables := doc.Find(".standard-table")
+	events := make(map[string]*event)
 
-	tables.Each(func(ind int, item *goquery.Selection) {
-		item.Eq(0).Find("tr").Each(func(i int, s *goquery.Selection) {
-			cols := s.Find("td")
-			if cols.Length() == 0 || cols.Find(".icon-thumbs-down-alt").Length() != 0 {
-				return
-			}
-			link := cols.Eq(0).Find("a").Eq(0)
-			var e event
+	for _, row := range rows {
+		var e event
 
-			// fmt.Printf("Name: %q -> %q\n", nameMap[link.Text()], link.Text())
+		if newName, ok := nameMap[row.Name]; ok {
+			e.Name = newName
+		} else {
+			e.Name = row.Name
+		}
 
-			if newName, ok := nameMap[link.Text()]; ok {
-				e.Name = newName
-			} else {
-				e.Name = link.Text()
-			}
+		e.Link = row.Link
+		e.Desc = row.Description
+		if e.Desc == "" {
+			e.Desc = "(no documentation)"
+		}
 
-			e.Link, _ = link.Attr("href")
-			e.Desc = strings.TrimSpace(cols.Eq(3).Text())
-			if e.Desc == "" {
-				e.Desc = "(no documentation)"
-			}
+		funName := funcName(e.Name)
 
-			funName := e.Name
-			if strings.Contains(funName, "-") {
-				parts := strings.Split(funName, "-")
-				for ind, sm := range parts {
-					parts[ind] = capitalize(sm)
-				}
+		if e.Name == "" || ignore[e.Name] {
+			continue
+		}
 
-				funName = strings.Join(parts, "")
-			}
+		e.Type = row.Name
 
-			funName = capitalize(funName)
+		e.Struct = structMap[e.Type]
+		if e.Struct == "" {
+			e.Struct = row.Interface
+		}
 
-			if e.Name == "" || ignore[e.Name] {
-				return
-			}
+		if !decodes(e.Struct) {
+			e.Struct = ""
+		}
 
-			events[funName] = &e
-		})
-	})
+		events[funName] = &e
+	}
 
 	var names []string
 	for name := range events {
@@ -181,12 +240,15 @@ func main() {
 // Documentation source: "Event reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/Events, licensed under CC-BY-SA 2.5.
 
 //Package events defines the event binding system that combines different libraries to create a interesting event system.
+//
+// The events are created with their DOM type as the trees.EventType, the name given to addEventListener like "keydown",
+// "dblclick" or "CssRuleViewChanged", and no longer the name of their function like "KeyDown" or "CSSRuleViewChange".
 package events
 
 import (
-	"github.com/gu-io/gu/trees"
 	"github.com/gu-io/gu/common"
-	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/trees"
 )
 
 
@@ -236,21 +298,81 @@ func %sEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	ops := append([]trees.EventOptions{trees.EventType(%q)}, options...)
 
 	ev := trees.NewEvent(ops...)
+	ev.Handler = handler
 
-	eventHandler := common.NewEventBroadcastHandler(func (evm common.EventBroadcast){
-		if ev.ID() != evm.EventID{
-			return
+	return ev
+}
+`, name, e.Desc, e.Link[6:], name, e.Type)
+
+		if renamed[e.Type] {
+			fmt.Fprintf(file, `
+// %sEvent returns a %sEvent.
+//
+// Deprecated: use %sEvent.
+func %sEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
+	return %sEvent(callback, options...)
+}
+`, funcName(e.Type), name, name, funcName(e.Type), name)
 		}
 
-		handler(evm.Event, ev.Tree)
-	})
+		if e.Struct == "" {
+			continue
+		}
 
-	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
+		fmt.Fprintf(file, `
+// On%s returns a %sEvent whose callback receives the event as a
+// *eventx.%s, the struct it is decoded into by the drivers. Events whose
+// value is not a *eventx.%s are not delivered to the callback.
+func On%s(callback func(*eventx.%s, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return %sEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.%s); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+`, name, name, e.Struct, e.Struct, name, e.Struct, name, e.Struct)
+	}
 
-	return ev
+	fmt.Fprint(file, `
+// EventStructs maps the types of the events with typed handlers to the name of
+// the eventx struct their values are decoded into.
+var EventStructs = map[string]string{
+`)
+
+	for _, name := range names {
+		if e := events[name]; e.Struct != "" {
+			fmt.Fprintf(file, "\t%q: %q,\n", e.Type, e.Struct)
+		}
+	}
+
+	fmt.Fprint(file, "}\n")
 }
-`, name, e.Desc, e.Link[6:], name, e.Name)
+
+// decodes returns true/false if drivers/core.GetEvent decodes events with the
+// giving name into a struct of the eventx package.
+func decodes(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	event, err := core.GetEvent(name, []byte("{}"), nil)
+	if err != nil {
+		return false
 	}
+
+	_, basic := event.Underlying().(*eventx.BasicEventMap)
+	return !basic
+}
+
+// funcName returns the name of the function of the event with the giving name,
+// capitalizing every part of names joined by dashes.
+func funcName(name string) string {
+	parts := strings.Split(name, "-")
+	for ind, sm := range parts {
+		parts[ind] = capitalize(sm)
+	}
+
+	return strings.Join(parts, "")
 }
 
 func capitalize(s string) string {
@@ -0,0 +1,48 @@
+package trees_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// TestEventOptions validates the delivery options of events are kept by clones
+// and serialized into their EventJSON.
+func TestEventOptions(t *testing.T) {
+	ev := trees.NewEvent(
+		trees.EventType("keydown"),
+		trees.Debounce(250*time.Millisecond),
+		trees.Throttle(time.Second),
+		trees.Once(),
+		trees.Passive(),
+		trees.OnKeys("Enter", "Escape"),
+	)
+
+	clone := ev.Clone()
+	if !clone.Once || !clone.Passive || clone.Debounce != 250*time.Millisecond || clone.Throttle != time.Second || strings.Join(clone.Keys, ",") != "Enter,Escape" {
+		t.Fatalf("\t%s\t Should have kept the options in the clone: %#v", failed, clone)
+	}
+	t.Logf("\t%s\t Should have kept the options in the clone", success)
+
+	data, err := json.Marshal(ev.EventJSON())
+	if err != nil {
+		t.Fatalf("\t%s\t Should have encoded the event: %s", failed, err)
+	}
+	t.Logf("\t%s\t Should have encoded the event", success)
+
+	for _, field := range []string{`"Once":true`, `"Passive":true`, `"Debounce":250`, `"Throttle":1000`, `"Keys":["Enter","Escape"]`} {
+		if !strings.Contains(string(data), field) {
+			t.Fatalf("\t%s\t Should have serialized %s: %s", failed, field, data)
+		}
+	}
+	t.Logf("\t%s\t Should have serialized the options in milliseconds", success)
+
+	data, _ = json.Marshal(trees.NewEvent(trees.EventType("click")).EventJSON())
+	if strings.Contains(string(data), "Debounce") || strings.Contains(string(data), "Keys") {
+		t.Fatalf("\t%s\t Should have left out unset options: %s", failed, data)
+	}
+	t.Logf("\t%s\t Should have left out unset options", success)
+}
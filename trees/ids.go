@@ -0,0 +1,176 @@
+package trees
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// IDGenerator defines the interface for types which generate the uids and
+// hashes given to markup, whether on creation through NewMarkup, when a hash
+// is updated through UpdateHash or when assigned over a whole tree through
+// AssignIDs.
+type IDGenerator interface {
+	// UID returns a uid for the giving markup.
+	UID(*Markup) string
+
+	// Hash returns a hash for the giving markup.
+	Hash(*Markup) string
+}
+
+// ids holds the IDGenerator used by the package when creating markup.
+var ids = struct {
+	r   sync.RWMutex
+	gen IDGenerator
+}{
+	gen: RandomIDs,
+}
+
+// GetIDGenerator returns the IDGenerator used when creating markup.
+func GetIDGenerator() IDGenerator {
+	ids.r.RLock()
+	defer ids.r.RUnlock()
+	return ids.gen
+}
+
+// SetIDGenerator sets the IDGenerator used when creating markup, a nil
+// generator restores RandomIDs.
+func SetIDGenerator(gen IDGenerator) {
+	if gen == nil {
+		gen = RandomIDs
+	}
+
+	ids.r.Lock()
+	defer ids.r.Unlock()
+	ids.gen = gen
+}
+
+// AssignIDs replaces the uid and hash of the root and all its children with
+// those produced by the giving generator. Uids are assigned from the root down
+// and hashes from the children up, so generators can derive the uid of markup
+// from its parent and its hash from its children.
+func AssignIDs(root *Markup, gen IDGenerator) {
+	root.uid = gen.UID(root)
+
+	for _, child := range root.children {
+		AssignIDs(child, gen)
+	}
+
+	root.hash = gen.Hash(root)
+}
+
+//==============================================================================
+
+// RandomIDs defines the default IDGenerator, which gives markup random uids
+// and hashes.
+var RandomIDs IDGenerator = randomIDs{}
+
+// randomIDs implements IDGenerator using RandString.
+type randomIDs struct{}
+
+// UID returns a random uid.
+func (randomIDs) UID(*Markup) string {
+	return RandString(8)
+}
+
+// Hash returns a random hash.
+func (randomIDs) Hash(*Markup) string {
+	return RandString(10)
+}
+
+//==============================================================================
+
+// SequentialIDs defines an IDGenerator which gives markup incremental uids and
+// hashes, which are the same across renders if the generator is reset before
+// each of them.
+type SequentialIDs struct {
+	prefix string
+	count  uint64
+}
+
+// NewSequentialIDs returns a new instance of SequentialIDs whose uids and
+// hashes start with the giving prefix.
+func NewSequentialIDs(prefix string) *SequentialIDs {
+	return &SequentialIDs{prefix: prefix}
+}
+
+// Reset restarts the sequence of the generator.
+func (s *SequentialIDs) Reset() {
+	atomic.StoreUint64(&s.count, 0)
+}
+
+// UID returns the next value of the sequence.
+func (s *SequentialIDs) UID(*Markup) string {
+	return s.next()
+}
+
+// Hash returns the next value of the sequence.
+func (s *SequentialIDs) Hash(*Markup) string {
+	return s.next()
+}
+
+// next returns the next value of the sequence with the prefix.
+func (s *SequentialIDs) next() string {
+	return s.prefix + strconv.FormatUint(atomic.AddUint64(&s.count, 1), 10)
+}
+
+//==============================================================================
+
+// ContentIDs defines an IDGenerator which derives the uid of markup from its
+// name, key and position within its parent and the uid of that parent, and its
+// hash from its content, attributes, styles and the hashes of its children.
+// Markup with the same content always gets the same ids, which makes it suited
+// for AssignIDs. The uid of markup only becomes unique once it has a parent,
+// so it should not be used with SetIDGenerator.
+var ContentIDs IDGenerator = contentIDs{}
+
+// contentIDs implements IDGenerator using sha1 digests of markup.
+type contentIDs struct{}
+
+// UID returns the digest of the position of the markup within its tree.
+func (contentIDs) UID(e *Markup) string {
+	digest := sha1.New()
+
+	if e.parent != nil {
+		writeDigest(digest, e.parent.uid, strconv.Itoa(childIndex(e.parent, e)))
+	}
+
+	writeDigest(digest, e.Name(), KeyOf(e))
+
+	return hex.EncodeToString(digest.Sum(nil))[:12]
+}
+
+// Hash returns the digest of the content of the markup.
+func (contentIDs) Hash(e *Markup) string {
+	digest := sha1.New()
+
+	writeDigest(digest, e.Name(), e.namespace, e.TextContent(), strconv.FormatBool(e.raw))
+
+	for _, attr := range e.attrs {
+		name, value := attr.Render()
+		writeDigest(digest, name, value)
+	}
+
+	for _, style := range e.styles {
+		name, value := style.Render()
+		writeDigest(digest, name, value)
+	}
+
+	for _, child := range e.children {
+		writeDigest(digest, child.hash)
+	}
+
+	return hex.EncodeToString(digest.Sum(nil))[:16]
+}
+
+// writeDigest writes the giving values into the digest, each followed by a
+// separator so values can not run into each other.
+func writeDigest(digest io.Writer, values ...string) {
+	for _, value := range values {
+		digest.Write([]byte(value))
+		digest.Write([]byte{0})
+	}
+}
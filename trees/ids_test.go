@@ -0,0 +1,103 @@
+package trees_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+func idsFixture(text string) *trees.Markup {
+	return trees.ParseAsRoot("section", `<ul><li>One</li><li>`+text+`</li></ul><p>Footer</p>`)
+}
+
+func collectIDs(root *trees.Markup) []string {
+	ids := []string{root.UID(), root.Hash()}
+
+	root.EachChild(func(child *trees.Markup) {
+		ids = append(ids, child.UID(), child.Hash())
+	})
+
+	return ids
+}
+
+// TestContentIDs validates ContentIDs gives the same ids to the same markup
+// and only changes the hashes of changed markup.
+func TestContentIDs(t *testing.T) {
+	first, second := idsFixture("Two"), idsFixture("Two")
+	trees.AssignIDs(first, trees.ContentIDs)
+	trees.AssignIDs(second, trees.ContentIDs)
+
+	firstIDs, secondIDs := collectIDs(first), collectIDs(second)
+	for index := range firstIDs {
+		if firstIDs[index] != secondIDs[index] {
+			t.Fatalf("\t%s\t Should have given the same ids to the same markup: %q != %q", failed, firstIDs[index], secondIDs[index])
+		}
+	}
+	t.Logf("\t%s\t Should have given the same ids to the same markup", success)
+
+	uids := make(map[string]bool)
+	first.EachChild(func(child *trees.Markup) {
+		uids[child.UID()] = true
+	})
+
+	if len(uids) != len(firstIDs)/2-1 {
+		t.Fatalf("\t%s\t Should have given every markup its own uid", failed)
+	}
+	t.Logf("\t%s\t Should have given every markup its own uid", success)
+
+	changed := idsFixture("Three")
+	trees.AssignIDs(changed, trees.ContentIDs)
+
+	if changed.UID() != first.UID() || changed.Hash() == first.Hash() {
+		t.Fatalf("\t%s\t Should have changed only the hash of changed markup", failed)
+	}
+	t.Logf("\t%s\t Should have changed only the hash of changed markup", success)
+
+	footer, changedFooter := trees.Select(first, "p").First(), trees.Select(changed, "p").First()
+	if footer.Hash() != changedFooter.Hash() {
+		t.Fatalf("\t%s\t Should have kept the hash of unchanged markup", failed)
+	}
+	t.Logf("\t%s\t Should have kept the hash of unchanged markup", success)
+}
+
+// TestSequentialIDs validates SequentialIDs produces the same ids once reset.
+func TestSequentialIDs(t *testing.T) {
+	gen := trees.NewSequentialIDs("id")
+
+	first := idsFixture("Two")
+	trees.AssignIDs(first, gen)
+
+	if first.UID() != "id1" {
+		t.Fatalf("\t%s\t Should have started the sequence with the prefix: %q", failed, first.UID())
+	}
+	t.Logf("\t%s\t Should have started the sequence with the prefix", success)
+
+	gen.Reset()
+
+	second := idsFixture("Two")
+	trees.AssignIDs(second, gen)
+
+	if first.HTML() != second.HTML() {
+		t.Fatalf("\t%s\t Should have produced the same html once reset: %q != %q", failed, first.HTML(), second.HTML())
+	}
+	t.Logf("\t%s\t Should have produced the same html once reset", success)
+}
+
+// TestSetIDGenerator validates markup is created with the ids of the set
+// generator.
+func TestSetIDGenerator(t *testing.T) {
+	defer trees.SetIDGenerator(trees.GetIDGenerator())
+	trees.SetIDGenerator(trees.NewSequentialIDs("seq-"))
+
+	div := trees.NewMarkup("div", false)
+	if div.UID() != "seq-1" || div.Hash() != "seq-2" {
+		t.Fatalf("\t%s\t Should have created markup with the set generator: %q %q", failed, div.UID(), div.Hash())
+	}
+	t.Logf("\t%s\t Should have created markup with the set generator", success)
+
+	div.UpdateHash()
+	if div.Hash() != "seq-3" {
+		t.Fatalf("\t%s\t Should have updated the hash with the set generator: %q", failed, div.Hash())
+	}
+	t.Logf("\t%s\t Should have updated the hash with the set generator", success)
+}
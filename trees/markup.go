@@ -12,8 +12,10 @@ import (
 // Markup represent a concrete implementation of a element node.
 type Markup struct {
 	ID              string
+	Key             string
 	removed         bool
 	autoclose       bool
+	raw             bool
 	allowEvents     bool
 	allowChildren   bool
 	allowStyles     bool
@@ -22,6 +24,7 @@ type Markup struct {
 	uid           string
 	hash          string
 	tagname       string
+	namespace     string
 	textContent   string
 	idSelector    string
 	textContentFn func(*Markup) string
@@ -51,10 +54,41 @@ func NewText(txt string, dl ...interface{}) *Markup {
 	return em
 }
 
+// NewComment returns a new Comment instance element, which is written out as
+// a html comment holding the giving text.
+func NewComment(txt string) *Markup {
+	em := NewText(txt)
+	em.tagname = commentName
+	return em
+}
+
+// NewDoctype returns a new Doctype instance element, which is written out as a
+// html doctype declaration with the giving content, such as 'html'.
+func NewDoctype(content string) *Markup {
+	em := NewText(content)
+	em.tagname = doctypeName
+	return em
+}
+
+// RawHTML returns a new Text instance element whose content is written out as
+// is by the printers, for trusted html which must not be escaped.
+func RawHTML(content string) *Markup {
+	em := NewText(content)
+	em.raw = true
+	return em
+}
+
+// Sanitizer defines an interface for types which clean out the markup not
+// allowed by a policy, as done by the policies of the trees/sanitize package.
+type Sanitizer interface {
+	Sanitize([]*Markup) []*Markup
+}
+
 // MarkdownTemplate returns a markup generated from a markup down string
 // which is built into a markup. If an error occured, it will be turned into
-// an error tag with the contents of the error.
-func MarkdownTemplate(tml string, bind interface{}) *Markup {
+// an error tag with the contents of the error. When sanitizers are provided,
+// the generated markup is passed through each of them in turn.
+func MarkdownTemplate(tml string, bind interface{}, sanitizers ...Sanitizer) *Markup {
 	processed, err := Templated(tml, bind, func(in string) string {
 		return string(blackfriday.MarkdownCommon([]byte(in)))
 	})
@@ -64,7 +98,20 @@ func MarkdownTemplate(tml string, bind interface{}) *Markup {
 		return ParseFirstOrMakeRoot("<error>" + err.Error() + "</error>")
 	}
 
-	return ParseFirstOrMakeRoot(processed)
+	if len(sanitizers) == 0 {
+		return ParseFirstOrMakeRoot(processed)
+	}
+
+	return FirstOrMakeRoot(Sanitize(ParseTree(processed), sanitizers...))
+}
+
+// Sanitize returns the markup passed through each of the sanitizers in turn.
+func Sanitize(markup []*Markup, sanitizers ...Sanitizer) []*Markup {
+	for _, sanitizer := range sanitizers {
+		markup = sanitizer.Sanitize(markup)
+	}
+
+	return markup
 }
 
 // CSSStylesheet provides a function that takes style rules which returns a stylesheet embeded into
@@ -109,20 +156,63 @@ func CSSStylesheet(styles interface{}, bind interface{}, ext *css.Rule, plain bo
 
 //==============================================================================
 
+// NodeKind defines the kind of node a Markup represents.
+type NodeKind int
+
+const (
+	// ElementNode defines the kind of Markup representing html elements.
+	ElementNode NodeKind = iota
+
+	// TextNode defines the kind of Markup representing text, including raw
+	// html.
+	TextNode
+
+	// CommentNode defines the kind of Markup representing html comments.
+	CommentNode
+
+	// DoctypeNode defines the kind of Markup representing doctype
+	// declarations.
+	DoctypeNode
+)
+
+// names of the markup which represent comments and doctypes.
+const (
+	commentName = "#comment"
+	doctypeName = "#doctype"
+)
+
+// NewNamespacedMarkup returns a new element instance giving the specified name
+// within the giving namespace, such as 'svg' or 'math', whose tag names keep
+// their case. An empty namespace is the same as using NewMarkup.
+func NewNamespacedMarkup(namespace string, tag string, autoClose bool) *Markup {
+	em := NewMarkup(tag, autoClose)
+
+	if namespace != "" {
+		em.namespace = namespace
+		em.tagname = strings.TrimSpace(tag)
+	}
+
+	return em
+}
+
 // NewMarkup returns a new element instance giving the specified name which is
 // used as a tag name.
 func NewMarkup(tag string, autoClose bool) *Markup {
-	return &Markup{
+	e := &Markup{
 		allowChildren:   true,
 		allowStyles:     true,
 		allowAttributes: true,
 		allowEvents:     true,
-		uid:             RandString(8),
-		hash:            RandString(10),
 		autoclose:       autoClose,
 		tagname:         strings.ToLower(strings.TrimSpace(tag)),
 		attrs:           []Property{NewAttr("data-gen", "gu")},
 	}
+
+	gen := GetIDGenerator()
+	e.uid = gen.UID(e)
+	e.hash = gen.Hash(e)
+
+	return e
 }
 
 // Empty resets the elements children list as 0 length
@@ -184,7 +274,11 @@ func (e *Markup) IDSelector(useParent bool) string {
 
 // MarshalJSON returns the html representation of the giving markup.
 func (e *Markup) MarshalJSON() ([]byte, error) {
-	return []byte(e.HTML()), nil
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	SimpleElementWriter.writeString(buf, e)
+	return append([]byte(nil), buf.Bytes()...), nil
 }
 
 // UnmarshalJSON the provided data and adds the giving children into the giving root.
@@ -196,7 +290,9 @@ func (e *Markup) UnmarshalJSON(data []byte) error {
 		e.attrs = item.attrs
 		e.textContent = item.textContent
 		e.textContentFn = item.textContentFn
+		e.raw = item.raw
 		e.tagname = item.tagname
+		e.namespace = item.namespace
 		e.styles = item.styles
 		e.events = item.events
 		e.allowStyles = item.allowStyles
@@ -204,6 +300,7 @@ func (e *Markup) UnmarshalJSON(data []byte) error {
 		e.allowEvents = item.allowEvents
 		e.allowChildren = item.allowChildren
 		e.ID = item.ID
+		e.Key = item.Key
 
 		item = nil
 		parsed = nil
@@ -222,13 +319,17 @@ func (e *Markup) UnmarshalJSON(data []byte) error {
 // escaped by go templates. The returned html is rendered using the default
 // SimpleElementWriter and represents the DOM of the giving element.
 func (e *Markup) EHTML() template.HTML {
-	return template.HTML(SimpleElementWriter.Print(e))
+	return template.HTML(e.HTML())
 }
 
 // HTML returns the html string representing the DOM of the giving element.
 //The returned html is rendered using the default SimpleElementWriter.
 func (e *Markup) HTML() string {
-	return SimpleElementWriter.Print(e)
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	SimpleElementWriter.writeString(buf, e)
+	return buf.String()
 }
 
 // AutoClosed returns true/false if this element uses a </> or a <></> tag convention
@@ -368,14 +469,18 @@ func (e *Markup) TextContent() string {
 
 // Clean cleans out all internal markup marked as removable.
 func (e *Markup) Clean() {
-	for n, elm := range e.children {
+	var children []*Markup
+
+	for _, elm := range e.children {
 		if elm.Removed() {
-			copy(e.children[n:], e.children[n+1:])
-			e.children = e.children[:len(e.children)-1]
-		} else {
-			elm.Clean()
+			continue
 		}
+
+		elm.Clean()
+		children = append(children, elm)
 	}
+
+	e.children = children
 }
 
 // Remove sets the markup as removable and adds a 'NodeRemoved' attribute to it.
@@ -404,6 +509,31 @@ func (e *Markup) UnRemove() {
 	}
 }
 
+// Kind returns the kind of node the markup represents.
+func (e *Markup) Kind() NodeKind {
+	switch e.tagname {
+	case "text":
+		return TextNode
+	case commentName:
+		return CommentNode
+	case doctypeName:
+		return DoctypeNode
+	default:
+		return ElementNode
+	}
+}
+
+// Namespace returns the namespace of the element, such as 'svg' or 'math',
+// which is empty for html elements.
+func (e *Markup) Namespace() string {
+	return e.namespace
+}
+
+// Raw returns true/false if the element is a text element holding raw html.
+func (e *Markup) Raw() bool {
+	return e.raw
+}
+
 // Removed returns true/false if the Element is marked removed
 func (e *Markup) Removed() bool {
 	return !!e.removed
@@ -419,9 +549,10 @@ func (e *Markup) SwapHash(hash string) {
 	e.hash = hash
 }
 
-// UpdateHash updates the Element hash value
+// UpdateHash updates the Element hash value using the IDGenerator set through
+// SetIDGenerator.
 func (e *Markup) UpdateHash() {
-	e.hash = RandString(10)
+	e.hash = GetIDGenerator().Hash(e)
 }
 
 // Reconcile takes a old markup and reconciles its uid and its children with
@@ -442,6 +573,10 @@ func (e *Markup) UpdateHash() {
 // here because they are the most volatile of the set and will periodically be
 // either changed and returned to normal values eg display: none to display: block
 // and vise-versa, so only attributes are used in the check process.
+// When all the children of both the old and new markup carry a key (either
+// through the Key field or a 'key' attribute), positioning stops mattering and
+// children are matched by their keys instead, which lets reordered lists keep
+// the uid and hash of every moved element.
 func (e *Markup) Reconcile(em *Markup) bool {
 	if e == em {
 		return false
@@ -465,7 +600,7 @@ func (e *Markup) Reconcile(em *Markup) bool {
 	oldHash := em.Hash()
 
 	// if we have a special case for text element then we do things differently
-	if e.Name() == "text" {
+	if e.Kind() != ElementNode {
 		if e.TextContent() == em.TextContent() {
 			e.SwapHash(oldHash)
 			return false
@@ -499,6 +634,21 @@ func (e *Markup) Reconcile(em *Markup) bool {
 
 	var childChanged bool
 
+	// if all children on both sides are keyed, then match them by key instead
+	// of by position, this lets moved nodes keep their uid and hash.
+	if hasKeys(newChildren) && hasKeys(oldChildren) {
+		if e.reconcileKeyed(newChildren, oldChildren) {
+			childChanged = true
+		}
+
+		if !childChanged && equalAttr && equalStyle {
+			e.SwapHash(oldHash)
+			return false
+		}
+
+		return true
+	}
+
 	for n, och := range oldChildren {
 		if maxSize > n {
 
@@ -531,6 +681,267 @@ func (e *Markup) Reconcile(em *Markup) bool {
 	return true
 }
 
+// reconcileKeyed reconciles the new and old children of a markup using their
+// keys, old children whose keys are no longer present are marked as removed and
+// added into the new children list. It returns true/false if any child changed
+// or was inserted, moved or removed.
+func (e *Markup) reconcileKeyed(newChildren []*Markup, oldChildren []*Markup) bool {
+	var childChanged bool
+
+	newChildren, _ = keyedChildren(newChildren)
+	oldChildren, _ = keyedChildren(oldChildren)
+
+	oldKeys := make(map[string]*Markup, len(oldChildren))
+	for _, och := range oldChildren {
+		oldKeys[KeyOf(och)] = och
+	}
+
+	newKeys := make(map[string]bool, len(newChildren))
+	for _, nch := range newChildren {
+		key := KeyOf(nch)
+		newKeys[key] = true
+
+		och, ok := oldKeys[key]
+		if !ok {
+			continue
+		}
+
+		// A child which keeps its key but changes its tag is a new node, so
+		// the old one is removed.
+		if och.Name() != nch.Name() {
+			och.Remove()
+			e.AddChild(och)
+			childChanged = true
+			continue
+		}
+
+		if nch.Reconcile(och) {
+			childChanged = true
+		}
+	}
+
+	for _, och := range oldChildren {
+		if newKeys[KeyOf(och)] {
+			continue
+		}
+
+		och.Remove()
+		e.AddChild(och)
+	}
+
+	if len(KeyedOps(oldChildren, newChildren)) != 0 {
+		childChanged = true
+	}
+
+	return childChanged
+}
+
+// KeyOf returns the key of the giving markup, using the Key field if set else
+// the value of its 'key' attribute if any.
+func KeyOf(e *Markup) string {
+	if e.Key != "" {
+		return e.Key
+	}
+
+	if attr, err := GetAttr(e, "key"); err == nil {
+		_, val := attr.Render()
+		return val
+	}
+
+	return ""
+}
+
+// keyedChildren returns the children which take part in keyed reconciliation,
+// leaving out whitespace-only text like the indentation between elements of
+// parsed markup, along with their positions in the giving list.
+func keyedChildren(children []*Markup) ([]*Markup, []int) {
+	var keyed []*Markup
+	var positions []int
+
+	for index, child := range children {
+		if child.Kind() == TextNode && strings.TrimSpace(child.TextContent()) == "" {
+			continue
+		}
+
+		keyed = append(keyed, child)
+		positions = append(positions, index)
+	}
+
+	return keyed, positions
+}
+
+// hasKeys returns true/false if all the provided markups, bar whitespace-only
+// text, have a key and no key is used twice, as duplicate keys can not be
+// matched by key and fall back to matching by position.
+func hasKeys(children []*Markup) bool {
+	children, _ = keyedChildren(children)
+	if len(children) == 0 {
+		return false
+	}
+
+	keys := make(map[string]bool, len(children))
+
+	for _, child := range children {
+		key := KeyOf(child)
+		if key == "" || keys[key] {
+			return false
+		}
+
+		keys[key] = true
+	}
+
+	return true
+}
+
+// KeyedOpType defines the type of operation produced by keyed reconciliation.
+type KeyedOpType int
+
+const (
+	// KeyedInsert defines the operation where a new keyed child is inserted.
+	KeyedInsert KeyedOpType = iota
+
+	// KeyedMove defines the operation where a keyed child changes position.
+	KeyedMove
+
+	// KeyedRemove defines the operation where a keyed child is removed.
+	KeyedRemove
+)
+
+// KeyedOp defines a single operation which transforms a old keyed children
+// list into the new one. From is the index in the old list and To the index
+// in the new list, both are -1 when not applicable.
+type KeyedOp struct {
+	Type KeyedOpType
+	Key  string
+	From int
+	To   int
+}
+
+// KeyedOps returns the minimal set of insert, move and remove operations needed
+// to turn the old keyed children list into the new one. Children which keep
+// their relative order (the longest increasing run of old positions) are not
+// moved, only those outside of it are. Whitespace-only text children are
+// ignored, while the From and To indexes still refer to the giving lists.
+func KeyedOps(oldChildren []*Markup, newChildren []*Markup) []KeyedOp {
+	oldChildren, oldPositions := keyedChildren(oldChildren)
+	newChildren, newPositions := keyedChildren(newChildren)
+
+	ops := keyedOps(oldChildren, newChildren)
+
+	for index, op := range ops {
+		if op.From != -1 {
+			ops[index].From = oldPositions[op.From]
+		}
+
+		if op.To != -1 {
+			ops[index].To = newPositions[op.To]
+		}
+	}
+
+	return ops
+}
+
+// keyedOps returns the operations of KeyedOps for lists of keyed children.
+func keyedOps(oldChildren []*Markup, newChildren []*Markup) []KeyedOp {
+	var ops []KeyedOp
+
+	oldIndex := make(map[string]int, len(oldChildren))
+	for index, och := range oldChildren {
+		oldIndex[KeyOf(och)] = index
+	}
+
+	newIndex := make(map[string]int, len(newChildren))
+	for index, nch := range newChildren {
+		newIndex[KeyOf(nch)] = index
+	}
+
+	for index, och := range oldChildren {
+		key := KeyOf(och)
+		if _, ok := newIndex[key]; ok {
+			continue
+		}
+
+		ops = append(ops, KeyedOp{Type: KeyedRemove, Key: key, From: index, To: -1})
+	}
+
+	// Collect the old positions of the retained children in their new order.
+	var kept []int
+	var keptAt []int
+
+	for index, nch := range newChildren {
+		from, ok := oldIndex[KeyOf(nch)]
+		if !ok {
+			continue
+		}
+
+		kept = append(kept, from)
+		keptAt = append(keptAt, index)
+	}
+
+	stable := longestIncreasing(kept)
+
+	var keptIndex int
+	for index, nch := range newChildren {
+		key := KeyOf(nch)
+
+		from, ok := oldIndex[key]
+		if !ok {
+			ops = append(ops, KeyedOp{Type: KeyedInsert, Key: key, From: -1, To: index})
+			continue
+		}
+
+		if !stable[keptIndex] {
+			ops = append(ops, KeyedOp{Type: KeyedMove, Key: key, From: from, To: keptAt[keptIndex]})
+		}
+
+		keptIndex++
+	}
+
+	return ops
+}
+
+// longestIncreasing returns a slice of flags marking the members of the
+// provided list which are part of its longest strictly increasing subsequence.
+func longestIncreasing(list []int) []bool {
+	marks := make([]bool, len(list))
+	if len(list) == 0 {
+		return marks
+	}
+
+	prev := make([]int, len(list))
+	var tails []int
+
+	for index, val := range list {
+		low, high := 0, len(tails)
+		for low < high {
+			mid := (low + high) / 2
+			if list[tails[mid]] < val {
+				low = mid + 1
+			} else {
+				high = mid
+			}
+		}
+
+		if low > 0 {
+			prev[index] = tails[low-1]
+		} else {
+			prev[index] = -1
+		}
+
+		if low == len(tails) {
+			tails = append(tails, index)
+		} else {
+			tails[low] = index
+		}
+	}
+
+	for index := tails[len(tails)-1]; index != -1; index = prev[index] {
+		marks[index] = true
+	}
+
+	return marks
+}
+
 // FirstChild returns the first child in the markup children list.
 func (e *Markup) FirstChild() *Markup {
 	return e.NthChild(0)
@@ -710,6 +1121,7 @@ func (e *Markup) CopyTo(co *Markup) {
 	// if co.textContent == "" {
 	co.textContent = e.textContent
 	co.textContentFn = e.textContentFn
+	co.raw = e.raw
 	// }
 
 	//clone the internal styles
@@ -750,11 +1162,15 @@ func (e *Markup) CopyTo(co *Markup) {
 // Clone makes a new copy of the markup structure
 func (e *Markup) Clone() *Markup {
 	co := NewMarkup(e.Name(), e.AutoClosed())
+	co.tagname = e.tagname
+	co.namespace = e.namespace
 
 	//copy over the textContent
 	co.textContent = e.textContent
 	co.textContentFn = e.textContentFn
+	co.raw = e.raw
 	co.ID = e.ID
+	co.Key = e.Key
 	co.hash = e.hash
 	co.uid = e.uid
 
@@ -10,6 +10,7 @@ import (
 	"text/template"
 
 	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 )
 
 // ParseTemplateInto parses the provided string has a template which
@@ -41,7 +42,12 @@ func ParseTemplate(markup string, binding interface{}) []*Markup {
 // ParseFirstOrMakeRoot attempts to parse the giving markup and returns the
 // element if only one else creates a div and adds all children as part of div.
 func ParseFirstOrMakeRoot(markup string) *Markup {
-	trees := ParseTree(markup)
+	return FirstOrMakeRoot(ParseTree(markup))
+}
+
+// FirstOrMakeRoot returns the element if it's the only one provided else
+// creates a div and adds all as its children.
+func FirstOrMakeRoot(trees []*Markup) *Markup {
 	if len(trees) == 1 {
 		return trees[0]
 	}
@@ -74,13 +80,11 @@ func ParseAndFirst(markup string) *Markup {
 // ParseAsRoot returns the markup generated from the provided markup,
 // returning them as children of the provided root.
 func ParseAsRoot(root string, markup string) *Markup {
-	tokens := html.NewTokenizer(strings.NewReader(markup))
-
 	var sel *Selector
 	if sels := Query.ParseSelector(root); sels != nil {
 		sel = sels[0]
 	} else {
-		sel.Tag = root
+		sel = &Selector{Tag: root}
 	}
 
 	rootElem := NewMarkup(sel.Tag, false)
@@ -93,7 +97,7 @@ func ParseAsRoot(root string, markup string) *Markup {
 		(&ClassList{list: sel.Classes}).Apply(rootElem)
 	}
 
-	pullNode(tokens, rootElem)
+	rootElem.AddChild(ParseTree(markup)...)
 
 	return rootElem
 }
@@ -114,79 +118,199 @@ func (c *cn) Next() int {
 	return c.val
 }
 
-// ParseTree takes a string markup and returns a *Markup which
-// contains the full structure transpiled
-// into the gutrees markup block structure.
+// voidElements defines the html elements which have no content or closing
+// tag, which are parsed as auto closed markup.
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"keygen": true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// fragmentContexts defines the element used as context for parsing markup
+// starting with the giving tag, for tags which are dropped by the html parser
+// outside of their expected parents.
+var fragmentContexts = map[string]atom.Atom{
+	"caption":  atom.Table,
+	"col":      atom.Colgroup,
+	"colgroup": atom.Table,
+	"tbody":    atom.Table,
+	"td":       atom.Tr,
+	"tfoot":    atom.Table,
+	"th":       atom.Tr,
+	"thead":    atom.Table,
+	"tr":       atom.Tbody,
+	"option":   atom.Select,
+	"optgroup": atom.Select,
+	"head":     atom.Html,
+	"body":     atom.Html,
+}
+
+// ParseTree takes a string markup and returns the *Markup trees it contains,
+// built using the html5 tree construction of golang.org/x/net/html. Markup
+// starting with a doctype or a html tag is parsed as a full document, while
+// any other is parsed as a fragment within a body element, or an element like
+// table for markup starting with tags like tr. Whitespace between the top
+// level elements is not kept.
 func ParseTree(markup string) []*Markup {
-	tokens := html.NewTokenizer(strings.NewReader(markup))
+	nodes, err := parseNodes(markup)
+	if err != nil {
+		return nil
+	}
 
-	rootElem := NewMarkup("div", false)
-	pullNode(tokens, rootElem)
+	var trees []*Markup
 
-	return rootElem.Children()
+	for _, node := range nodes {
+		if node.Type == html.TextNode && strings.TrimSpace(node.Data) == "" {
+			continue
+		}
+
+		if elem := fromNode(node); elem != nil {
+			trees = append(trees, elem)
+		}
+	}
+
+	return trees
 }
 
-func pullNode(tokens *html.Tokenizer, root *Markup) {
+// parseNodes returns the top level html nodes of the giving markup.
+func parseNodes(markup string) ([]*html.Node, error) {
+	tokens := html.NewTokenizer(strings.NewReader(markup))
+
+	context := atom.Body
+
 	for {
 		token := tokens.Next()
+		if token == html.ErrorToken {
+			break
+		}
 
-		switch token {
-		case html.ErrorToken:
-			return
+		if token == html.DoctypeToken {
+			return parseDocument(markup)
+		}
 
-		case html.TextToken, html.CommentToken, html.DoctypeToken:
-			text := strings.TrimSpace(string(tokens.Text()))
+		if token != html.StartTagToken && token != html.SelfClosingTagToken {
+			continue
+		}
 
-			if text == "" {
-				continue
-			}
+		name, _ := tokens.TagName()
+		if string(name) == "html" {
+			return parseDocument(markup)
+		}
 
-			if token == html.CommentToken {
-				text = "<!--" + text + "-->"
-			}
+		if ctx, ok := fragmentContexts[string(name)]; ok {
+			context = ctx
+		}
 
-			// if node != nil {
-			// 	NewText(text).Apply(node)
-			// 	continue
-			// }
+		break
+	}
 
-			NewText(text).Apply(root)
-			continue
+	return html.ParseFragment(strings.NewReader(markup), &html.Node{
+		Type:     html.ElementNode,
+		Data:     context.String(),
+		DataAtom: context,
+	})
+}
+
+// parseDocument returns the top level nodes of the giving markup parsed as a
+// full html document.
+func parseDocument(markup string) ([]*html.Node, error) {
+	doc, err := html.Parse(strings.NewReader(markup))
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*html.Node
+	for node := doc.FirstChild; node != nil; node = node.NextSibling {
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+// fromNode returns the *Markup for the giving html node and its children.
+func fromNode(node *html.Node) *Markup {
+	switch node.Type {
+	case html.TextNode:
+		return NewText(node.Data)
 
-		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
-			tagName, hasAttr := tokens.TagName()
+	case html.CommentNode:
+		return NewComment(node.Data)
 
-			// fmt.Printf("Token: %#v -> %+q -> %q -> %t\n", token, token, tagName, token == html.SelfClosingTagToken)
+	case html.DoctypeNode:
+		doctype := node.Data
 
-			if token == html.EndTagToken && string(tagName) == root.tagname {
-				return
+		var public, system string
+		for _, attr := range node.Attr {
+			switch attr.Key {
+			case "public":
+				public = attr.Val
+			case "system":
+				system = attr.Val
 			}
+		}
 
-			node := NewMarkup(string(tagName), token == html.SelfClosingTagToken)
-			node.Apply(root)
+		switch {
+		case public != "":
+			doctype += ` PUBLIC "` + public + `"`
+			if system != "" {
+				doctype += ` "` + system + `"`
+			}
+		case system != "":
+			doctype += ` SYSTEM "` + system + `"`
+		}
 
-			if hasAttr {
-			attrLoop:
-				for {
-					key, val, more := tokens.TagAttr()
+		return NewDoctype(doctype)
 
-					if string(key) != "" {
-						NewAttr(string(key), string(val)).Apply(node)
-					}
+	case html.ElementNode:
+		elem := NewNamespacedMarkup(node.Namespace, node.Data, node.Namespace == "" && voidElements[node.Data])
 
-					if !more {
-						break attrLoop
-					}
-				}
+		for _, attr := range node.Attr {
+			name := attr.Key
+			if attr.Namespace != "" {
+				name = attr.Namespace + ":" + attr.Key
 			}
 
-			if token == html.SelfClosingTagToken {
+			// skip the attributes added by the printers, which the element
+			// already has or gets when printed, adopting its printed hash and
+			// uid.
+			switch {
+			case name == "data-gen" && attr.Val == "gu", name == "style" && attr.Val == "":
+				continue
+			case name == "hash":
+				elem.SwapHash(attr.Val)
+				continue
+			case name == "uid":
+				elem.SwapUID(attr.Val)
 				continue
 			}
 
-			pullNode(tokens, node)
+			// names are used as is, as the parser lowercases html attributes
+			// while foreign ones like 'viewBox' keep their case.
+			(&Attribute{Name: name, Value: attr.Val}).Apply(elem)
 		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if item := fromNode(child); item != nil {
+				item.Apply(elem)
+			}
+		}
+
+		return elem
 	}
+
+	return nil
 }
 
 // ParseTreeToText takes a string markup and returns a *Markup which
@@ -259,7 +383,7 @@ func traverseNode(w io.Writer, tokens *html.Tokenizer, pnode html.Token, count c
 func writeNode(w io.Writer, node html.Token, parent string, elementName string) {
 	switch node.Type {
 	case html.CommentToken:
-		writeText(w, "trees.NewText(\"<---%s --->\").Apply(%s)", node.Data, parent)
+		writeText(w, "trees.NewComment(%q).Apply(%s)", node.Data, parent)
 		return
 	case html.StartTagToken, html.SelfClosingTagToken:
 		writeText(w, "%s := trees.NewMarkup(%q, %t)\n%s.Apply(%s)", elementName, node.Data, node.Type == html.SelfClosingTagToken, elementName, parent)
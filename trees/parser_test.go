@@ -2,10 +2,14 @@ package trees_test
 
 import (
 	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/gu-io/gu/trees"
+	"golang.org/x/net/html"
 )
 
 var success = "\u2713"
@@ -106,3 +110,170 @@ func TestParser(t *testing.T) {
 
 	t.Logf("\t%s\t Parser should have produced markup for html: %q", success, strings.Join(html, ""))
 }
+
+// TestParserNodeKinds validates comments, doctypes, void elements, whitespace
+// and namespaces are kept by the parser.
+func TestParserNodeKinds(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	result := trees.ParseTree("<!DOCTYPE html><html><head></head><body><!-- note --><br><pre>\n\nindented\n  code</pre><svg viewBox=\"0 0 1 1\"><linearGradient/></svg></body></html>")
+
+	if len(result) != 2 || result[0].Kind() != trees.DoctypeNode || result[1].Name() != "html" {
+		t.Fatalf("\t%s\t Should have parsed the doctype and html elements: %d", failed, len(result))
+	}
+	t.Logf("\t%s\t Should have parsed the doctype and html elements", success)
+
+	body := result[1].Children()[1]
+
+	if comment := body.Children()[0]; comment.Kind() != trees.CommentNode || comment.HTML() != "<!-- note -->" {
+		t.Fatalf("\t%s\t Should have parsed the comment: %q", failed, comment.HTML())
+	}
+	t.Logf("\t%s\t Should have parsed the comment", success)
+
+	if br := body.Children()[1]; br.Name() != "br" || !br.AutoClosed() {
+		t.Fatalf("\t%s\t Should have parsed void elements as auto closed", failed)
+	}
+	t.Logf("\t%s\t Should have parsed void elements as auto closed", success)
+
+	if pre := body.Children()[2]; pre.HTML() != "<pre data-gen=\"gu\" style=\"\">\n\nindented\n  code</pre>" {
+		t.Fatalf("\t%s\t Should have kept the whitespace of pre elements: %q", failed, pre.HTML())
+	}
+	t.Logf("\t%s\t Should have kept the whitespace of pre elements", success)
+
+	svg := body.Children()[3]
+	if svg.Namespace() != "svg" || svg.Children()[0].Name() != "linearGradient" {
+		t.Fatalf("\t%s\t Should have kept the namespace and case of svg elements", failed)
+	}
+	t.Logf("\t%s\t Should have kept the namespace and case of svg elements", success)
+
+	if _, err := trees.GetAttr(svg, "viewBox"); err != nil {
+		t.Fatalf("\t%s\t Should have kept the case of svg attributes", failed)
+	}
+	t.Logf("\t%s\t Should have kept the case of svg attributes", success)
+
+	rows := trees.ParseTree("<tr><td>1</td></tr>")
+	if len(rows) != 1 || rows[0].Name() != "tr" || rows[0].Children()[0].Name() != "td" {
+		t.Fatalf("\t%s\t Should have parsed table rows outside of a table", failed)
+	}
+	t.Logf("\t%s\t Should have parsed table rows outside of a table", success)
+}
+
+// TestParserRoundTrip validates the html produced from parsed pages is parsed
+// into the same document as the pages.
+func TestParserRoundTrip(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	pages, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil || len(pages) == 0 {
+		t.Fatalf("\t%s\t Should have found pages to parse: %v", failed, err)
+	}
+
+	for _, page := range pages {
+		content, err := ioutil.ReadFile(page)
+		if err != nil {
+			t.Fatalf("\t%s\t Should have read page %q: %q", failed, page, err)
+		}
+
+		output := render(trees.ParseTree(string(content)))
+
+		expected, err := html.Parse(bytes.NewReader(content))
+		if err != nil {
+			t.Fatalf("\t%s\t Should have parsed page %q: %q", failed, page, err)
+		}
+
+		produced, err := html.Parse(strings.NewReader(output))
+		if err != nil {
+			t.Fatalf("\t%s\t Should have parsed output of page %q: %q", failed, page, err)
+		}
+
+		if diff := compareNodes(expected, produced, page); diff != "" {
+			t.Fatalf("\t%s\t Should have produced the same document for page %q: %s", failed, page, diff)
+		}
+		t.Logf("\t%s\t Should have produced the same document for page %q", success, page)
+
+		if again := render(trees.ParseTree(output)); again != output {
+			t.Fatalf("\t%s\t Should have produced the same output when parsing output of page %q", failed, page)
+		}
+		t.Logf("\t%s\t Should have produced the same output when parsing output of page %q", success, page)
+	}
+}
+
+func render(markup []*trees.Markup) string {
+	var out []string
+	for _, item := range markup {
+		out = append(out, item.HTML())
+	}
+
+	return strings.Join(out, "")
+}
+
+// compareNodes returns a description of the first difference found between
+// the giving nodes, ignoring the attributes added by the printers.
+func compareNodes(expected, produced *html.Node, path string) string {
+	path = path + "/" + expected.Data
+
+	if expected.Type != produced.Type || expected.Data != produced.Data || expected.Namespace != produced.Namespace {
+		return fmt.Sprintf("%s: expected %q (%d) found %q (%d)", path, expected.Data, expected.Type, produced.Data, produced.Type)
+	}
+
+	if ea, pa := attrsOf(expected), attrsOf(produced); ea != pa {
+		return fmt.Sprintf("%s: expected attributes %q found %q", path, ea, pa)
+	}
+
+	ec, pc := expected.FirstChild, produced.FirstChild
+	for ; ec != nil && pc != nil; ec, pc = ec.NextSibling, pc.NextSibling {
+		if diff := compareNodes(ec, pc, path); diff != "" {
+			return diff
+		}
+	}
+
+	if ec != nil || pc != nil {
+		return fmt.Sprintf("%s: expected the same number of children", path)
+	}
+
+	return ""
+}
+
+func attrsOf(node *html.Node) string {
+	var attrs []string
+
+	for _, attr := range node.Attr {
+		if attr.Key == "data-gen" || attr.Key == "style" && attr.Val == "" {
+			continue
+		}
+
+		attrs = append(attrs, attr.Namespace+":"+attr.Key+"="+attr.Val)
+	}
+
+	return strings.Join(attrs, " ")
+}
+
+// TestParserAdoptsIDs validates that the hash and uid printed by gu are adopted
+// by the parsed elements instead of being added as attributes.
+func TestParserAdoptsIDs(t *testing.T) {
+	div := trees.NewMarkup("div", false)
+	trees.NewAttr("class", "box").Apply(div)
+
+	parsed := trees.ParseFirstOrMakeRoot(div.HTML())
+
+	if parsed.UID() != div.UID() || parsed.Hash() != div.Hash() {
+		t.Fatalf("\t%s\t Should have adopted the printed uid and hash: %q %q", failed, parsed.UID(), parsed.Hash())
+	}
+	t.Logf("\t%s\t Should have adopted the printed uid and hash", success)
+
+	if _, err := trees.GetAttr(parsed, "uid"); err == nil {
+		t.Fatalf("\t%s\t Should not have added the uid as an attribute", failed)
+	}
+
+	if _, err := trees.GetAttr(parsed, "hash"); err == nil {
+		t.Fatalf("\t%s\t Should not have added the hash as an attribute", failed)
+	}
+	t.Logf("\t%s\t Should not have added the uid and hash as attributes", success)
+
+	if again := trees.ParseFirstOrMakeRoot(parsed.HTML()); again.HTML() != parsed.HTML() {
+		t.Fatalf("\t%s\t Should have printed the same markup once reparsed: %q", failed, again.HTML())
+	}
+	t.Logf("\t%s\t Should have printed the same markup once reparsed", success)
+}
@@ -62,7 +62,9 @@ type AttrWriter struct{}
 
 const attrformt = ` %s="%s"`
 
-// Print returns a stringed repesentation of the attribute object
+// Print returns a stringed repesentation of the attribute object, escaping
+// the values of the attributes and leaving out those with invalid names or
+// which hold scripts, like event handlers and srcdoc.
 func (m AttrWriter) Print(a []Property) string {
 	if len(a) <= 0 {
 		return ""
@@ -72,7 +74,11 @@ func (m AttrWriter) Print(a []Property) string {
 
 	for _, ar := range a {
 		name, val := ar.Render()
-		attrs = append(attrs, fmt.Sprintf(attrformt, name, val))
+		if !validAttrName(name) || scriptAttr(name) {
+			continue
+		}
+
+		attrs = append(attrs, fmt.Sprintf(attrformt, name, escapeAttr(name, val)))
 	}
 
 	return strings.Join(attrs, " ")
@@ -93,7 +99,8 @@ type StyleWriter struct{}
 
 const styleformt = " %s:%s;"
 
-// Print returns a stringed repesentation of the style object
+// Print returns a stringed repesentation of the style object, replacing unsafe
+// values with FilteredValue and leaving out styles with invalid names.
 func (m StyleWriter) Print(s []Property) string {
 	if len(s) <= 0 {
 		return ""
@@ -103,7 +110,11 @@ func (m StyleWriter) Print(s []Property) string {
 
 	for _, cs := range s {
 		name, val := cs.Render()
-		css = append(css, fmt.Sprintf(styleformt, name, val))
+		if !validCSSName.MatchString(name) {
+			continue
+		}
+
+		css = append(css, fmt.Sprintf(styleformt, name, escapeStyle(val)))
 	}
 
 	return strings.Join(css, " ")
@@ -122,9 +133,14 @@ type TextWriter struct{}
 // SimpleTextWriter provides a basic text writer
 var SimpleTextWriter TextWriter
 
-// Print returns the string representation of the text object
+// Print returns the string representation of the text object, escaped
+// according to the element containing it unless it's raw html.
 func (m TextWriter) Print(t *Markup) string {
-	return t.TextContent()
+	if t.Raw() {
+		return t.TextContent()
+	}
+
+	return escapeText(t.parent, t.TextContent())
 }
 
 //==============================================================================
@@ -153,66 +169,14 @@ func (m *ElementWriter) Write(ma *Markup) (string, error) {
 	return m.Print(ma), nil
 }
 
-// Print returns the string representation of the element
+// Print returns the string representation of the element, written through
+// the same streaming writer used by WriteTo.
 func (m *ElementWriter) Print(e *Markup) string {
-	if e.Removed() && GetMode() > Normal {
-		return ""
-	}
-
-	//if we are dealing with a text type just return the content
-	if e.Name() == "text" {
-		return m.text.Print(e)
-	}
-
-	// Management attributes.
-	var mido []Property
-
-	// Collect uid and hash of the element so we can write them along.
-	if GetMode() < Pretty {
-		hash := &Attribute{Name: "hash", Value: e.Hash()}
-		uid := &Attribute{Name: "uid", Value: e.UID()}
-		mido = append(mido, hash, uid)
-	}
-
-	//write out the hash and uid as attributes
-	hashes := m.attrWriter.Print(mido)
-
-	//write out the elements attributes using the AttrWriter
-	attrs := m.attrWriter.Print(e.Attributes())
-
-	//write out the elements inline-styles using the StyleWriter
-	style := m.styleWriter.Print(e.Styles())
-
-	var closer string
-	var beginbrack string
-
-	if e.AutoClosed() {
-		closer = "/>"
-	} else {
-		beginbrack = ">"
-		closer = fmt.Sprintf("</%s>", e.Name())
-	}
-
-	var children = []string{}
-	for _, ch := range e.Children() {
-		if ch.UID() == e.UID() {
-			continue
-		}
-
-		children = append(children, m.Print(ch))
-	}
+	buf := getBuffer()
+	defer putBuffer(buf)
 
-	//lets create the elements markup now
-	return strings.Join([]string{
-		fmt.Sprintf("<%s", e.Name()),
-		hashes,
-		attrs,
-		fmt.Sprintf(` style=%q`, style),
-		beginbrack,
-		e.TextContent(),
-		strings.Join(children, ""),
-		closer,
-	}, "")
+	m.writeString(buf, e)
+	return buf.String()
 }
 
 //==============================================================================
@@ -0,0 +1,75 @@
+package trees
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrintBaseline returns the string representation of the element as Print did
+// before writing through the streaming writer, kept for BenchmarkPrintBaseline.
+func (m *ElementWriter) PrintBaseline(e *Markup) string {
+	if e.Removed() && GetMode() > Normal {
+		return ""
+	}
+
+	switch e.Kind() {
+	case TextNode:
+		//if we are dealing with a text type just return the content
+		return m.text.Print(e)
+	case CommentNode:
+		return "<!--" + escapeComment(e.TextContent()) + "-->"
+	case DoctypeNode:
+		return "<!DOCTYPE " + escapeDoctype(e.TextContent()) + ">"
+	}
+
+	// Management attributes.
+	var mido []Property
+
+	// Collect uid and hash of the element so we can write them along.
+	if GetMode() < Pretty {
+		hash := &Attribute{Name: "hash", Value: e.Hash()}
+		uid := &Attribute{Name: "uid", Value: e.UID()}
+		mido = append(mido, hash, uid)
+	}
+
+	//write out the hash and uid as attributes
+	hashes := m.attrWriter.Print(mido)
+
+	//write out the elements attributes using the AttrWriter
+	attrs := m.attrWriter.Print(e.Attributes())
+
+	//write out the elements inline-styles using the StyleWriter
+	style := m.styleWriter.Print(e.Styles())
+
+	var closer string
+	var beginbrack string
+
+	if e.AutoClosed() {
+		closer = "/>"
+	} else {
+		beginbrack = ">"
+		closer = fmt.Sprintf("</%s>", e.Name())
+	}
+
+	var children = []string{}
+	for _, ch := range e.Children() {
+		if ch.UID() == e.UID() {
+			continue
+		}
+
+		children = append(children, m.PrintBaseline(ch))
+	}
+
+	//lets create the elements markup now
+	return strings.Join([]string{
+		fmt.Sprintf("<%s", e.Name()),
+		hashes,
+		attrs,
+		fmt.Sprintf(` style="%s"`, attrEscaper.Replace(style)),
+		beginbrack,
+		leadingNewline(e),
+		escapeText(e, e.TextContent()),
+		strings.Join(children, ""),
+		closer,
+	}, "")
+}
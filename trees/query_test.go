@@ -1,6 +1,7 @@
 package trees_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/gu-io/gu/trees"
@@ -157,3 +158,120 @@ func TestQueries(t *testing.T) {
 	tests.Passed("Should have returned 3 elements for selector 'section.section'")
 
 }
+
+// selectorFixture contains the markup the selector cases are matched against,
+// derived from the examples of the Selectors Level 3 specification.
+const selectorFixture = `
+<div id="main" class="content main">
+  <h1 lang="en-US">Title</h1>
+  <p class="note warning" title="first">One</p>
+  <p class="note">Two</p>
+  <blockquote><p>Quoted</p></blockquote>
+  <p>Three</p>
+  <ul>
+    <li>1</li><li>2</li><li>3</li><li>4</li><li>5</li><li>6</li><li>7</li>
+  </ul>
+  <a href="https://gu.io/docs.pdf" hreflang="en">Docs</a>
+  <a href="/local#top" hreflang="fr-CA" rel="nofollow noopener">Local</a>
+  <span></span>
+  <span><!-- comment --></span>
+  <span> </span>
+</div>
+`
+
+func queryTexts(root *trees.Markup, sel string) []string {
+	var texts []string
+
+	for _, item := range trees.Query.QueryAll(root, sel) {
+		var text []string
+		for _, child := range item.Children() {
+			if child.Kind() == trees.TextNode {
+				text = append(text, child.TextContent())
+			}
+		}
+
+		texts = append(texts, item.Name()+":"+strings.TrimSpace(strings.Join(text, "")))
+	}
+
+	return texts
+}
+
+func TestSelectors(t *testing.T) {
+	root := trees.ParseAsRoot("body", selectorFixture)
+
+	cases := []struct {
+		selector string
+		expected []string
+	}{
+		{"h1", []string{"h1:Title"}},
+		{"*.warning", []string{"p:One"}},
+		{"p.note.warning", []string{"p:One"}},
+		{".note:not(.warning)", []string{"p:Two"}},
+		{"#main > p", []string{"p:One", "p:Two", "p:Three"}},
+		{"div p", []string{"p:One", "p:Two", "p:Quoted", "p:Three"}},
+		{"h1 + p", []string{"p:One"}},
+		{"h1 ~ p", []string{"p:One", "p:Two", "p:Three"}},
+		{"blockquote + p", []string{"p:Three"}},
+		{"blockquote>p", []string{"p:Quoted"}},
+		{"h1, blockquote p", []string{"h1:Title", "p:Quoted"}},
+		{"[title]", []string{"p:One"}},
+		{"[class=note]", []string{"p:Two"}},
+		{"[class~=warning]", []string{"p:One"}},
+		{"[lang|=en]", []string{"h1:Title"}},
+		{"[hreflang|='fr']", []string{"a:Local"}},
+		{"a[href^=\"https://\"]", []string{"a:Docs"}},
+		{"a[href$='.pdf']", []string{"a:Docs"}},
+		{"a[href*=\"#\"]", []string{"a:Local"}},
+		{"a[rel~=noopener]", []string{"a:Local"}},
+		{"a[HREFLANG=EN i]", []string{"a:Docs"}},
+		{"[href^='']", nil},
+		{"li:nth-child(2n+1)", []string{"li:1", "li:3", "li:5", "li:7"}},
+		{"li:nth-child(odd)", []string{"li:1", "li:3", "li:5", "li:7"}},
+		{"li:nth-child(even)", []string{"li:2", "li:4", "li:6"}},
+		{"li:nth-child(-n+3)", []string{"li:1", "li:2", "li:3"}},
+		{"li:nth-child(3n - 1)", []string{"li:2", "li:5"}},
+		{"li:nth-child(5)", []string{"li:5"}},
+		{"li:nth-child(0n+0)", nil},
+		{"li:nth-last-child(-n+2)", []string{"li:6", "li:7"}},
+		{"li:first-child", []string{"li:1"}},
+		{"li:last-child", []string{"li:7"}},
+		{"ul > :only-child", nil},
+		{"p:first-of-type", []string{"p:One", "p:Quoted"}},
+		{"p:last-of-type", []string{"p:Quoted", "p:Three"}},
+		{"#main > p:nth-of-type(2)", []string{"p:Two"}},
+		{"#main > p:nth-last-of-type(1)", []string{"p:Three"}},
+		{"blockquote p:only-of-type", []string{"p:Quoted"}},
+		{"span:empty", []string{"span:", "span:"}},
+		{"div > :not(p):not(span):not(a)", []string{"h1:Title", "blockquote:", "ul:"}},
+		{"p:not(.note, blockquote p)", []string{"p:Three"}},
+	}
+
+	for _, tc := range cases {
+		found := queryTexts(root, tc.selector)
+
+		if strings.Join(found, "|") != strings.Join(tc.expected, "|") {
+			tests.Failed("Should have matched %q with selector %q: found %q", tc.expected, tc.selector, found)
+		}
+		tests.Passed("Should have matched %q with selector %q", tc.expected, tc.selector)
+	}
+}
+
+func TestInvalidSelectors(t *testing.T) {
+	for _, sel := range []string{"", "div >", "p..note", "a[href", "a[href=x", "li:nth-child(x)", "p::before", "p:unknown", "div)", "a[href!=x]"} {
+		if _, err := trees.CompileSelector(sel); err == nil {
+			tests.Failed("Should have failed to compile invalid selector %q", sel)
+		}
+		tests.Passed("Should have failed to compile invalid selector %q", sel)
+	}
+
+	first, err := trees.CompileSelector("div > p")
+	if err != nil {
+		tests.Failed("Should have compiled selector: %q", err)
+	}
+	tests.Passed("Should have compiled selector")
+
+	if second, _ := trees.CompileSelector("div > p"); second != first {
+		tests.Failed("Should have returned the cached selector")
+	}
+	tests.Passed("Should have returned the cached selector")
+}
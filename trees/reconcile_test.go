@@ -0,0 +1,271 @@
+package trees_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+func keyedList(keys ...string) *trees.Markup {
+	list := trees.NewMarkup("ul", false)
+
+	for _, key := range keys {
+		item := trees.NewMarkup("li", false)
+		item.Key = key
+		trees.NewText(key).Apply(item)
+		item.Apply(list)
+	}
+
+	return list
+}
+
+func uidsByKey(list *trees.Markup) map[string]string {
+	uids := make(map[string]string)
+	for _, child := range list.Children() {
+		uids[trees.KeyOf(child)] = child.UID()
+	}
+
+	return uids
+}
+
+func countOps(ops []trees.KeyedOp, kind trees.KeyedOpType) int {
+	var total int
+	for _, op := range ops {
+		if op.Type == kind {
+			total++
+		}
+	}
+
+	return total
+}
+
+// TestKeyedReconcilePrepend validates that prepending to a keyed list keeps
+// the uids of the existing items and only inserts the new one.
+func TestKeyedReconcilePrepend(t *testing.T) {
+	old := keyedList("a", "b", "c")
+	oldUIDs := uidsByKey(old)
+	oldHashes := make(map[string]string)
+	for _, child := range old.Children() {
+		oldHashes[trees.KeyOf(child)] = child.Hash()
+	}
+
+	newer := keyedList("z", "a", "b", "c")
+
+	ops := trees.KeyedOps(old.Children(), newer.Children())
+	if len(ops) != 1 || ops[0].Type != trees.KeyedInsert || ops[0].Key != "z" || ops[0].To != 0 {
+		t.Fatalf("\t%s\t Should have produced a single insert operation: %#v", failed, ops)
+	}
+	t.Logf("\t%s\t Should have produced a single insert operation", success)
+
+	if !newer.Reconcile(old) {
+		t.Fatalf("\t%s\t Should have reported a change in the list", failed)
+	}
+	t.Logf("\t%s\t Should have reported a change in the list", success)
+
+	for _, child := range newer.Children() {
+		key := trees.KeyOf(child)
+		if key == "z" {
+			continue
+		}
+
+		if child.Removed() {
+			t.Fatalf("\t%s\t Should not have marked %q as removed", failed, key)
+		}
+
+		if child.UID() != oldUIDs[key] {
+			t.Fatalf("\t%s\t Should have kept uid for %q", failed, key)
+		}
+
+		if child.Hash() != oldHashes[key] {
+			t.Fatalf("\t%s\t Should have kept hash for %q", failed, key)
+		}
+	}
+	t.Logf("\t%s\t Should have kept uids and hashes for existing items", success)
+}
+
+// TestKeyedReconcileShuffle validates that reordering a keyed list keeps uids
+// and produces only the moves outside the longest stable run.
+func TestKeyedReconcileShuffle(t *testing.T) {
+	old := keyedList("a", "b", "c", "d", "e")
+	oldUIDs := uidsByKey(old)
+
+	newer := keyedList("e", "a", "b", "c", "d")
+
+	ops := trees.KeyedOps(old.Children(), newer.Children())
+	if len(ops) != 1 || ops[0].Type != trees.KeyedMove || ops[0].Key != "e" {
+		t.Fatalf("\t%s\t Should have produced a single move operation: %#v", failed, ops)
+	}
+	t.Logf("\t%s\t Should have produced a single move operation", success)
+
+	if ops[0].From != 4 || ops[0].To != 0 {
+		t.Fatalf("\t%s\t Should have moved %q from 4 to 0: %#v", failed, "e", ops[0])
+	}
+	t.Logf("\t%s\t Should have moved %q from 4 to 0", success, "e")
+
+	newer.Reconcile(old)
+
+	if len(newer.Children()) != 5 {
+		t.Fatalf("\t%s\t Should not have added any removed items: %d", failed, len(newer.Children()))
+	}
+	t.Logf("\t%s\t Should not have added any removed items", success)
+
+	for key, uid := range uidsByKey(newer) {
+		if oldUIDs[key] != uid {
+			t.Fatalf("\t%s\t Should have kept uid for %q", failed, key)
+		}
+	}
+	t.Logf("\t%s\t Should have kept uids for all shuffled items", success)
+
+	reversed := keyedList("d", "c", "b", "a")
+	ops = trees.KeyedOps(keyedList("a", "b", "c", "d").Children(), reversed.Children())
+	if countOps(ops, trees.KeyedMove) != 3 {
+		t.Fatalf("\t%s\t Should have produced three moves for a reversed list: %#v", failed, ops)
+	}
+	t.Logf("\t%s\t Should have produced three moves for a reversed list", success)
+}
+
+// TestKeyedReconcileDeleteMiddle validates that removing an item in the middle
+// of a keyed list only removes that item.
+func TestKeyedReconcileDeleteMiddle(t *testing.T) {
+	old := keyedList("a", "b", "c", "d")
+	oldUIDs := uidsByKey(old)
+
+	newer := keyedList("a", "b", "d")
+
+	ops := trees.KeyedOps(old.Children(), newer.Children())
+	if len(ops) != 1 || ops[0].Type != trees.KeyedRemove || ops[0].Key != "c" || ops[0].From != 2 {
+		t.Fatalf("\t%s\t Should have produced a single remove operation: %#v", failed, ops)
+	}
+	t.Logf("\t%s\t Should have produced a single remove operation", success)
+
+	newer.Reconcile(old)
+
+	children := newer.Children()
+	if len(children) != 4 {
+		t.Fatalf("\t%s\t Should have added removed item into list: %d", failed, len(children))
+	}
+	t.Logf("\t%s\t Should have added removed item into list", success)
+
+	for _, child := range children {
+		key := trees.KeyOf(child)
+
+		if key == "c" {
+			if !child.Removed() {
+				t.Fatalf("\t%s\t Should have marked %q as removed", failed, key)
+			}
+			continue
+		}
+
+		if child.Removed() {
+			t.Fatalf("\t%s\t Should not have marked %q as removed", failed, key)
+		}
+
+		if child.UID() != oldUIDs[key] {
+			t.Fatalf("\t%s\t Should have kept uid for %q", failed, key)
+		}
+	}
+	t.Logf("\t%s\t Should have only removed the deleted item", success)
+}
+
+// TestKeyedReconcileAttribute validates that the 'key' attribute is used when
+// the Key field is not set.
+func TestKeyedReconcileAttribute(t *testing.T) {
+	old := trees.ParseFirstOrMakeRoot(`<ul><li key="1">One</li><li key="2">Two</li></ul>`)
+	oldUIDs := uidsByKey(old)
+
+	newer := trees.ParseFirstOrMakeRoot(`<ul><li key="2">Two</li><li key="1">One</li></ul>`)
+	newer.Reconcile(old)
+
+	for key, uid := range uidsByKey(newer) {
+		if oldUIDs[key] != uid {
+			t.Fatalf("\t%s\t Should have kept uid for attribute key %q", failed, key)
+		}
+	}
+	t.Logf("\t%s\t Should have kept uids for attribute keyed items", success)
+}
+
+// TestKeyedReconcileTagChange validates that a keyed child which changes its
+// tag replaces the old child instead of being reconciled with it.
+func TestKeyedReconcileTagChange(t *testing.T) {
+	old := trees.ParseFirstOrMakeRoot(`<ul><li key="1">One</li><li key="2">Two</li></ul>`)
+	newer := trees.ParseFirstOrMakeRoot(`<ul><li key="1">One</li><p key="2">Two</p></ul>`)
+
+	if !newer.Reconcile(old) {
+		t.Fatalf("\t%s\t Should have reported a change in the list", failed)
+	}
+	t.Logf("\t%s\t Should have reported a change in the list", success)
+
+	children := newer.Children()
+	if len(children) != 3 || !children[2].Removed() || children[2].Name() != "li" {
+		t.Fatalf("\t%s\t Should have removed the old child of the changed tag: %d", failed, len(children))
+	}
+	t.Logf("\t%s\t Should have removed the old child of the changed tag", success)
+}
+
+// TestKeyedReconcileDuplicateKeys validates that lists with duplicate keys are
+// reconciled by position, removing every old child.
+func TestKeyedReconcileDuplicateKeys(t *testing.T) {
+	old := keyedList("a", "a", "b")
+	newer := keyedList("a")
+
+	if !newer.Reconcile(old) {
+		t.Fatalf("\t%s\t Should have reported a change in the list", failed)
+	}
+	t.Logf("\t%s\t Should have reported a change in the list", success)
+
+	var removed int
+	for _, child := range newer.Children() {
+		if child.Removed() {
+			removed++
+		}
+	}
+
+	if removed != 2 {
+		t.Fatalf("\t%s\t Should have removed both shadowed children: %d", failed, removed)
+	}
+	t.Logf("\t%s\t Should have removed both shadowed children", success)
+
+	patches := trees.Diff(keyedList("a", "a", "b"), keyedList("a"))
+
+	var removes int
+	for _, patch := range patches {
+		if patch.Type == trees.RemoveChildPatch {
+			removes++
+		}
+	}
+
+	if removes != 2 {
+		t.Fatalf("\t%s\t Should have patched away both shadowed children: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have patched away both shadowed children", success)
+}
+
+// TestKeyedReconcileWhitespace validates that whitespace between keyed
+// children of parsed markup does not turn off keyed reconciliation.
+func TestKeyedReconcileWhitespace(t *testing.T) {
+	old := trees.ParseFirstOrMakeRoot("<ul>\n  <li key=\"1\">One</li>\n  <li key=\"2\">Two</li>\n</ul>")
+	oldUIDs := uidsByKey(old)
+
+	newer := trees.ParseFirstOrMakeRoot("<ul>\n  <li key=\"2\">Two</li>\n  <li key=\"1\">One</li>\n</ul>")
+
+	ops := trees.KeyedOps(old.Children(), newer.Children())
+	if len(ops) != 1 || ops[0].Type != trees.KeyedMove || ops[0].From != 3 || ops[0].To != 1 {
+		t.Fatalf("\t%s\t Should have moved within the positions of the giving lists: %#v", failed, ops)
+	}
+	t.Logf("\t%s\t Should have moved within the positions of the giving lists", success)
+
+	newer.Reconcile(old)
+
+	for key, uid := range uidsByKey(newer) {
+		if key != "" && oldUIDs[key] != uid {
+			t.Fatalf("\t%s\t Should have kept uid for %q", failed, key)
+		}
+	}
+	t.Logf("\t%s\t Should have kept uids across whitespace", success)
+
+	patches := trees.Diff(trees.ParseFirstOrMakeRoot("<ul>\n  <li key=\"1\">One</li>\n  <li key=\"2\">Two</li>\n</ul>"), newer)
+	if _, ok := findPatch(patches, trees.MoveChildPatch); !ok {
+		t.Fatalf("\t%s\t Should have diffed the children by key: %#v", failed, patches)
+	}
+	t.Logf("\t%s\t Should have diffed the children by key", success)
+}
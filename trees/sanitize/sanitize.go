@@ -0,0 +1,279 @@
+// Package sanitize provides allow-list policies which clean out elements,
+// attributes, styles and urls from trees markup, for rendering markup which
+// comes from untrusted sources, such as user-authored markdown.
+package sanitize
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// skipContent defines the elements which are removed along with their content
+// when not allowed, rather than being replaced by their children.
+var skipContent = map[string]bool{
+	"applet":   true,
+	"embed":    true,
+	"frame":    true,
+	"frameset": true,
+	"iframe":   true,
+	"noscript": true,
+	"object":   true,
+	"script":   true,
+	"style":    true,
+	"template": true,
+}
+
+// urlAttributes defines the attributes whose values are checked against the
+// allowed url schemes of a policy.
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"codebase":   true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"icon":       true,
+	"longdesc":   true,
+	"manifest":   true,
+	"poster":     true,
+	"src":        true,
+	"usemap":     true,
+	"xlink:href": true,
+}
+
+// Policy defines a set of allowed elements, attributes and url schemes which
+// markup is sanitized against. Elements which are not allowed are replaced by
+// their sanitized children, except for elements like 'script' and 'style'
+// which are removed with their content. Text is always kept while events,
+// morphers, comments, doctypes and raw html are always removed.
+type Policy struct {
+	elements   map[string]bool
+	attributes map[string]map[string]bool
+	schemes    map[string]bool
+	styles     bool
+}
+
+// NewPolicy returns a new Policy which allows nothing but text.
+func NewPolicy() *Policy {
+	return &Policy{
+		elements:   make(map[string]bool),
+		attributes: make(map[string]map[string]bool),
+		schemes:    make(map[string]bool),
+	}
+}
+
+// UGC returns a new Policy suited for user-generated content such as the
+// output of markdown, allowing text formatting, lists, tables, links and
+// images with http, https and mailto urls.
+func UGC() *Policy {
+	return NewPolicy().
+		AllowElements(
+			"a", "abbr", "b", "blockquote", "br", "caption", "code", "dd", "del",
+			"div", "dl", "dt", "em", "figcaption", "figure", "h1", "h2", "h3",
+			"h4", "h5", "h6", "hr", "i", "img", "ins", "kbd", "li", "mark", "ol",
+			"p", "pre", "q", "s", "small", "span", "strike", "strong", "sub",
+			"sup", "table", "tbody", "td", "tfoot", "th", "thead", "tr", "u", "ul",
+		).
+		AllowAttributes("", "title", "lang", "dir").
+		AllowAttributes("a", "href", "name").
+		AllowAttributes("img", "src", "alt", "width", "height").
+		AllowAttributes("code", "class").
+		AllowAttributes("ol", "start").
+		AllowAttributes("td", "align", "colspan", "rowspan").
+		AllowAttributes("th", "align", "colspan", "rowspan", "scope").
+		AllowAttributes("blockquote", "cite").
+		AllowAttributes("q", "cite").
+		AllowSchemes("http", "https", "mailto")
+}
+
+// Clone returns a copy of the policy which can be changed without affecting
+// the policy.
+func (p *Policy) Clone() *Policy {
+	co := NewPolicy()
+	co.styles = p.styles
+
+	for name := range p.elements {
+		co.elements[name] = true
+	}
+
+	for scheme := range p.schemes {
+		co.schemes[scheme] = true
+	}
+
+	for element, attrs := range p.attributes {
+		co.attributes[element] = make(map[string]bool, len(attrs))
+
+		for name := range attrs {
+			co.attributes[element][name] = true
+		}
+	}
+
+	return co
+}
+
+// AllowElements adds the giving element names to the allowed elements of the
+// policy.
+func (p *Policy) AllowElements(names ...string) *Policy {
+	for _, name := range names {
+		p.elements[strings.ToLower(name)] = true
+	}
+
+	return p
+}
+
+// AllowAttributes adds the giving attribute names to the allowed attributes of
+// the giving element. An empty element name allows the attributes on all
+// allowed elements.
+func (p *Policy) AllowAttributes(element string, names ...string) *Policy {
+	element = strings.ToLower(element)
+
+	attrs, ok := p.attributes[element]
+	if !ok {
+		attrs = make(map[string]bool)
+		p.attributes[element] = attrs
+	}
+
+	for _, name := range names {
+		attrs[strings.ToLower(name)] = true
+	}
+
+	return p
+}
+
+// AllowSchemes adds the giving schemes to the url schemes allowed in url
+// attributes such as 'href' and 'src'. Relative urls are always allowed.
+func (p *Policy) AllowSchemes(schemes ...string) *Policy {
+	for _, scheme := range schemes {
+		p.schemes[strings.ToLower(scheme)] = true
+	}
+
+	return p
+}
+
+// AllowStyles allows the inline styles added to the allowed elements through
+// trees.CSSStyle, 'style' attributes are never allowed.
+func (p *Policy) AllowStyles() *Policy {
+	p.styles = true
+	return p
+}
+
+// Sanitize returns new markup built from the giving markup, holding only the
+// elements, attributes, styles and urls allowed by the policy. The giving
+// markup is left unchanged.
+func (p *Policy) Sanitize(markup []*trees.Markup) []*trees.Markup {
+	var sanitized []*trees.Markup
+
+	for _, item := range markup {
+		sanitized = append(sanitized, p.sanitize(item)...)
+	}
+
+	return sanitized
+}
+
+// SanitizeHTML parses the giving html and returns the markup allowed by the
+// policy.
+func (p *Policy) SanitizeHTML(markup string) []*trees.Markup {
+	return p.Sanitize(trees.ParseTree(markup))
+}
+
+// sanitize returns the markup allowed by the policy for the giving markup,
+// which is more than a single element when a disallowed element is replaced
+// by its children.
+func (p *Policy) sanitize(item *trees.Markup) []*trees.Markup {
+	if item == nil || item.Removed() || item.Raw() {
+		return nil
+	}
+
+	name := item.Name()
+
+	switch item.Kind() {
+	case trees.TextNode:
+		return []*trees.Markup{trees.NewText(item.TextContent())}
+	case trees.CommentNode, trees.DoctypeNode:
+		return nil
+	}
+
+	if !p.elements[name] {
+		if skipContent[name] {
+			return nil
+		}
+
+		return p.children(item)
+	}
+
+	clean := trees.NewMarkup(name, item.AutoClosed())
+	clean.ID = item.ID
+	clean.Key = item.Key
+
+	for _, attr := range item.Attributes() {
+		key, value := attr.Render()
+		if key == "data-gen" || !p.allowedAttr(name, key, value) {
+			continue
+		}
+
+		trees.NewAttr(key, value).Apply(clean)
+	}
+
+	if p.styles {
+		for _, style := range item.Styles() {
+			style.Clone().Apply(clean)
+		}
+	}
+
+	if text := item.TextContent(); text != "" {
+		trees.NewText(text).Apply(clean)
+	}
+
+	clean.AddChild(p.children(item)...)
+
+	return []*trees.Markup{clean}
+}
+
+// children returns the sanitized children of the giving markup.
+func (p *Policy) children(item *trees.Markup) []*trees.Markup {
+	var children []*trees.Markup
+
+	for _, child := range item.Children() {
+		children = append(children, p.sanitize(child)...)
+	}
+
+	return children
+}
+
+// allowedAttr returns true/false if the giving attribute is allowed on the
+// giving element.
+func (p *Policy) allowedAttr(element string, name string, value string) bool {
+	name = strings.ToLower(name)
+
+	if name == "style" {
+		return false
+	}
+
+	if !p.attributes[""][name] && !p.attributes[element][name] {
+		return false
+	}
+
+	if urlAttributes[name] {
+		return p.allowedURL(value)
+	}
+
+	return true
+}
+
+// allowedURL returns true/false if the giving url is relative or uses one of
+// the allowed schemes.
+func (p *Policy) allowedURL(value string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return false
+	}
+
+	if parsed.Scheme == "" {
+		return true
+	}
+
+	return p.schemes[strings.ToLower(parsed.Scheme)]
+}
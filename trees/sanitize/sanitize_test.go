@@ -0,0 +1,111 @@
+package sanitize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/sanitize"
+	"github.com/influx6/faux/tests"
+)
+
+func render(markup []*trees.Markup) string {
+	var out []string
+
+	for _, item := range markup {
+		out = append(out, item.HTML())
+	}
+
+	return strings.Join(out, "")
+}
+
+func TestPolicy(t *testing.T) {
+	defer trees.SetMode(trees.GetMode())
+	trees.SetMode(trees.Pretty)
+
+	policy := sanitize.NewPolicy().
+		AllowElements("p", "a", "img").
+		AllowAttributes("a", "href").
+		AllowAttributes("", "title").
+		AllowSchemes("https")
+
+	clean := policy.SanitizeHTML(`<p title="intro" onclick="alert(1)">Hello <b>bold</b> <a href="javascript:alert(1)">bad</a><a href="https://gu.io" target="_blank">good</a><script>alert(1)</script></p>`)
+
+	if len(clean) != 1 || clean[0].Name() != "p" {
+		tests.Failed("Should have kept the allowed root element: %q", render(clean))
+	}
+	tests.Passed("Should have kept the allowed root element")
+
+	out := render(clean)
+
+	if strings.Contains(out, "onclick") || strings.Contains(out, "target") {
+		tests.Failed("Should have removed attributes which are not allowed: %q", out)
+	}
+	tests.Passed("Should have removed attributes which are not allowed")
+
+	if !strings.Contains(out, `title="intro"`) {
+		tests.Failed("Should have kept attributes allowed on all elements: %q", out)
+	}
+	tests.Passed("Should have kept attributes allowed on all elements")
+
+	if strings.Contains(out, "<b") || !strings.Contains(out, "bold") {
+		tests.Failed("Should have replaced elements which are not allowed by their content: %q", out)
+	}
+	tests.Passed("Should have replaced elements which are not allowed by their content")
+
+	if strings.Contains(out, "script") || strings.Contains(out, "alert") {
+		tests.Failed("Should have removed scripts with their content: %q", out)
+	}
+	tests.Passed("Should have removed scripts with their content")
+
+	if !strings.Contains(out, `<a data-gen="gu" style="">bad</a>`) || !strings.Contains(out, `href="https://gu.io"`) {
+		tests.Failed("Should have kept only urls with allowed schemes: %q", out)
+	}
+	tests.Passed("Should have kept only urls with allowed schemes")
+}
+
+func TestPolicyClone(t *testing.T) {
+	base := sanitize.NewPolicy().AllowElements("p")
+	extended := base.Clone().AllowElements("em")
+
+	markup := trees.ParseTree(`<p><em>text</em></p>`)
+
+	if out := render(base.Sanitize(markup)); strings.Contains(out, "<em") {
+		tests.Failed("Should have left the cloned policy unchanged: %q", out)
+	}
+	tests.Passed("Should have left the cloned policy unchanged")
+
+	if out := render(extended.Sanitize(markup)); !strings.Contains(out, "<em") {
+		tests.Failed("Should have allowed elements added to the clone: %q", out)
+	}
+	tests.Passed("Should have allowed elements added to the clone")
+
+	if len(markup[0].Children()) != 1 || markup[0].Children()[0].Name() != "em" {
+		tests.Failed("Should have left the sanitized markup unchanged")
+	}
+	tests.Passed("Should have left the sanitized markup unchanged")
+}
+
+func TestMarkdown(t *testing.T) {
+	md := "# Title\n\n[link](javascript:alert(1)) <img src=x onerror=alert(1)> <iframe src=\"https://evil.com\"></iframe>\n"
+
+	out := elems.Markdown(md, sanitize.UGC()).HTML()
+
+	if !strings.Contains(out, "<h1") || !strings.Contains(out, "Title") {
+		tests.Failed("Should have kept the markdown formatting: %q", out)
+	}
+	tests.Passed("Should have kept the markdown formatting")
+
+	if strings.Contains(out, "javascript") || strings.Contains(out, "onerror") || strings.Contains(out, "iframe") {
+		tests.Failed("Should have sanitized the markdown output: %q", out)
+	}
+	tests.Passed("Should have sanitized the markdown output")
+
+	templated := trees.MarkdownTemplate("Hello {{.}} <script>alert(1)</script>", "World", sanitize.UGC()).HTML()
+
+	if !strings.Contains(templated, "Hello World") || strings.Contains(templated, "script") {
+		tests.Failed("Should have sanitized the markdown template output: %q", templated)
+	}
+	tests.Passed("Should have sanitized the markdown template output")
+}
@@ -0,0 +1,541 @@
+package trees
+
+import "strings"
+
+// Selection defines a list of elements within one or more markup trees, which
+// can be traversed and changed as a whole. Every change made through a
+// selection keeps the parent of the changed elements in place and updates the
+// hash of the changed elements and their ancestors, so that later calls to
+// Reconcile see the changes.
+type Selection struct {
+	items []*Markup
+}
+
+// Select returns a new Selection holding the elements within the root which
+// match the giving css selector. An invalid selector returns an empty
+// selection.
+func Select(root *Markup, sel string) *Selection {
+	compiled, err := CompileSelector(sel)
+	if err != nil || root == nil {
+		return &Selection{}
+	}
+
+	return &Selection{items: compiled.QueryAll(root)}
+}
+
+// SelectMarkup returns a new Selection holding the giving markup.
+func SelectMarkup(items ...*Markup) *Selection {
+	return newSelection(items)
+}
+
+// newSelection returns a new Selection holding the giving markup, skipping
+// nil and repeated items.
+func newSelection(items []*Markup) *Selection {
+	seen := make(map[*Markup]bool, len(items))
+	unique := make([]*Markup, 0, len(items))
+
+	for _, item := range items {
+		if item == nil || seen[item] {
+			continue
+		}
+
+		seen[item] = true
+		unique = append(unique, item)
+	}
+
+	return &Selection{items: unique}
+}
+
+// Len returns the total elements within the selection.
+func (s *Selection) Len() int {
+	return len(s.items)
+}
+
+// Markup returns the elements within the selection.
+func (s *Selection) Markup() []*Markup {
+	return s.items
+}
+
+// First returns the first element of the selection or nil if empty.
+func (s *Selection) First() *Markup {
+	if len(s.items) == 0 {
+		return nil
+	}
+
+	return s.items[0]
+}
+
+// Each calls the giving function for every element of the selection with its
+// index and returns the selection.
+func (s *Selection) Each(fn func(int, *Markup)) *Selection {
+	for index, item := range s.items {
+		fn(index, item)
+	}
+
+	return s
+}
+
+//==============================================================================
+
+// Select returns a new Selection holding the elements within the elements of
+// the selection which match the giving css selector.
+func (s *Selection) Select(sel string) *Selection {
+	compiled, err := CompileSelector(sel)
+	if err != nil {
+		return &Selection{}
+	}
+
+	var found []*Markup
+	for _, item := range s.items {
+		found = append(found, compiled.QueryAll(item)...)
+	}
+
+	return newSelection(found)
+}
+
+// Filter returns a new Selection holding the elements of the selection which
+// match the giving css selector.
+func (s *Selection) Filter(sel string) *Selection {
+	compiled, err := CompileSelector(sel)
+	if err != nil {
+		return &Selection{}
+	}
+
+	return s.FilterFunc(compiled.Match)
+}
+
+// FilterFunc returns a new Selection holding the elements of the selection for
+// which the giving function returns true.
+func (s *Selection) FilterFunc(fn func(*Markup) bool) *Selection {
+	var found []*Markup
+
+	for _, item := range s.items {
+		if fn(item) {
+			found = append(found, item)
+		}
+	}
+
+	return newSelection(found)
+}
+
+// Closest returns a new Selection holding the first element matching the
+// giving css selector for every element of the selection, testing the element
+// itself and then its ancestors.
+func (s *Selection) Closest(sel string) *Selection {
+	compiled, err := CompileSelector(sel)
+	if err != nil {
+		return &Selection{}
+	}
+
+	var found []*Markup
+	for _, item := range s.items {
+		for current := item; current != nil; current = parentElement(current) {
+			if compiled.Match(current) {
+				found = append(found, current)
+				break
+			}
+		}
+	}
+
+	return newSelection(found)
+}
+
+// Parent returns a new Selection holding the parents of the elements of the
+// selection.
+func (s *Selection) Parent() *Selection {
+	var found []*Markup
+
+	for _, item := range s.items {
+		found = append(found, parentElement(item))
+	}
+
+	return newSelection(found)
+}
+
+// Siblings returns a new Selection holding the sibling elements of the
+// elements of the selection, excluding the elements themselves.
+func (s *Selection) Siblings() *Selection {
+	var found []*Markup
+
+	for _, item := range s.items {
+		if item.parent == nil {
+			continue
+		}
+
+		for _, child := range item.parent.children {
+			if child != item && child.Kind() == ElementNode && !child.Removed() {
+				found = append(found, child)
+			}
+		}
+	}
+
+	return newSelection(found)
+}
+
+// Next returns a new Selection holding the element which follows each element
+// of the selection within its parent.
+func (s *Selection) Next() *Selection {
+	var found []*Markup
+
+	for _, item := range s.items {
+		found = append(found, nextElement(item))
+	}
+
+	return newSelection(found)
+}
+
+// Prev returns a new Selection holding the element which precedes each
+// element of the selection within its parent.
+func (s *Selection) Prev() *Selection {
+	var found []*Markup
+
+	for _, item := range s.items {
+		found = append(found, previousElement(item))
+	}
+
+	return newSelection(found)
+}
+
+//==============================================================================
+
+// AddClass adds the giving class names to the elements of the selection,
+// skipping the names an element already has.
+func (s *Selection) AddClass(classes ...string) *Selection {
+	for _, item := range s.items {
+		value, _ := attrValue(item, "class")
+		list := strings.Fields(value)
+
+		var changed bool
+		for _, class := range classes {
+			for _, name := range strings.Fields(class) {
+				if !containsWord(list, name) {
+					list = append(list, name)
+					changed = true
+				}
+			}
+		}
+
+		if changed {
+			setClasses(item, list)
+		}
+	}
+
+	return s
+}
+
+// RemoveClass removes the giving class names from the elements of the
+// selection.
+func (s *Selection) RemoveClass(classes ...string) *Selection {
+	var removed []string
+	for _, class := range classes {
+		removed = append(removed, strings.Fields(class)...)
+	}
+
+	for _, item := range s.items {
+		value, ok := attrValue(item, "class")
+		if !ok {
+			continue
+		}
+
+		var list []string
+		for _, name := range strings.Fields(value) {
+			if !containsWord(removed, name) {
+				list = append(list, name)
+			}
+		}
+
+		if len(list) != len(strings.Fields(value)) {
+			setClasses(item, list)
+		}
+	}
+
+	return s
+}
+
+// SetAttr sets the value of the attribute with the giving name on the elements
+// of the selection, adding the attribute where missing.
+func (s *Selection) SetAttr(name string, value string) *Selection {
+	for _, item := range s.items {
+		if !item.allowAttributes {
+			continue
+		}
+
+		if current, ok := attrValue(item, name); ok && current == value {
+			continue
+		}
+
+		ReplaceORAddAttribute(item, name, value)
+		touch(item)
+	}
+
+	return s
+}
+
+// SetText replaces the children of the elements of the selection with a
+// single text node holding the giving text.
+func (s *Selection) SetText(text string) *Selection {
+	for _, item := range s.items {
+		if !item.allowChildren {
+			continue
+		}
+
+		for _, child := range item.children {
+			child.parent = nil
+		}
+
+		item.children = nil
+		item.AddChild(NewText(text))
+		touch(item)
+	}
+
+	return s
+}
+
+// Wrap wraps every element of the selection with the giving markup, placing
+// the wrapper where the element was and adding the element as its last child.
+// Elements after the first are wrapped with a clone of the wrapper.
+func (s *Selection) Wrap(wrapper *Markup) *Selection {
+	if wrapper == nil || !wrapper.allowChildren || len(s.items) == 0 {
+		return s
+	}
+
+	// clone the wrappers before changing the tree, else later wrappers would
+	// carry the elements added into the first.
+	wrappers := []*Markup{wrapper}
+	for range s.items[1:] {
+		wrappers = append(wrappers, cloneMarkup(wrapper))
+	}
+
+	for index, item := range s.items {
+		wrap := wrappers[index]
+		if wrap == item {
+			continue
+		}
+
+		if parent := item.parent; parent != nil {
+			replaceChild(parent, item, wrap)
+		}
+
+		wrap.AddChild(item)
+		touch(item)
+	}
+
+	return s
+}
+
+// ReplaceWith replaces every element of the selection with the giving markup
+// within its parent and returns a new Selection holding the replacements.
+// Elements after the first are replaced with a clone of the markup.
+func (s *Selection) ReplaceWith(markup *Markup) *Selection {
+	if markup == nil {
+		return &Selection{}
+	}
+
+	var replaced []*Markup
+
+	for _, item := range s.items {
+		parent := item.parent
+		if parent == nil {
+			continue
+		}
+
+		replacement := markup
+		if len(replaced) > 0 {
+			replacement = cloneMarkup(markup)
+		}
+
+		replaceChild(parent, item, replacement)
+		replaced = append(replaced, replacement)
+		touch(replacement)
+	}
+
+	return newSelection(replaced)
+}
+
+// InsertBefore adds the giving markup before every element of the selection
+// within its parent. Elements after the first receive clones of the markup.
+func (s *Selection) InsertBefore(markup ...*Markup) *Selection {
+	return s.insert(markup, 0)
+}
+
+// InsertAfter adds the giving markup after every element of the selection
+// within its parent. Elements after the first receive clones of the markup.
+func (s *Selection) InsertAfter(markup ...*Markup) *Selection {
+	return s.insert(markup, 1)
+}
+
+// insert adds the giving markup into the parent of every element of the
+// selection, at the position of the element moved by offset.
+func (s *Selection) insert(markup []*Markup, offset int) *Selection {
+	var inserted int
+
+	for _, item := range s.items {
+		parent := item.parent
+		if parent == nil || !parent.allowChildren {
+			continue
+		}
+
+		list := markup
+		if inserted > 0 {
+			list = make([]*Markup, 0, len(markup))
+			for _, child := range markup {
+				list = append(list, cloneMarkup(child))
+			}
+		}
+
+		children := make([]*Markup, 0, len(list))
+		for _, child := range list {
+			if child != nil && child != item {
+				detach(child)
+				children = append(children, child)
+			}
+		}
+
+		index := childIndex(parent, item)
+		if index == -1 {
+			continue
+		}
+
+		insertChildren(parent, index+offset, children)
+		inserted++
+		touch(parent)
+	}
+
+	return s
+}
+
+//==============================================================================
+
+// nextElement returns the element following the element within its parent.
+func nextElement(e *Markup) *Markup {
+	if e.parent == nil {
+		return nil
+	}
+
+	var found bool
+	for _, child := range e.parent.children {
+		if child == e {
+			found = true
+			continue
+		}
+
+		if found && child.Kind() == ElementNode && !child.Removed() {
+			return child
+		}
+	}
+
+	return nil
+}
+
+// touch updates the hash of the element and all its ancestors, marking them
+// as changed for reconciliation.
+func touch(e *Markup) {
+	for current := e; current != nil; current = current.parent {
+		current.UpdateHash()
+	}
+}
+
+// setClasses replaces the 'class' attributes of the element with a single one
+// holding the giving class names, removing it if none are given.
+func setClasses(e *Markup, classes []string) {
+	if !e.allowAttributes {
+		return
+	}
+
+	attrs := e.attrs[:0]
+	for _, attr := range e.attrs {
+		if name, _ := attr.Render(); name != "class" {
+			attrs = append(attrs, attr)
+		}
+	}
+
+	e.attrs = attrs
+
+	if len(classes) != 0 {
+		e.attrs = append(e.attrs, NewClassList(classes...))
+	}
+
+	touch(e)
+}
+
+// childIndex returns the index of the child within the children of the
+// parent, or -1 if not found.
+func childIndex(parent *Markup, child *Markup) int {
+	for index, item := range parent.children {
+		if item == child {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// detach removes the element from the children of its parent.
+func detach(e *Markup) {
+	parent := e.parent
+	if parent == nil {
+		return
+	}
+
+	if index := childIndex(parent, e); index != -1 {
+		parent.children = append(parent.children[:index], parent.children[index+1:]...)
+		touch(parent)
+	}
+
+	e.parent = nil
+}
+
+// replaceChild puts the replacement in the place of the child within the
+// parent, detaching the replacement from its previous parent.
+func replaceChild(parent *Markup, child *Markup, replacement *Markup) {
+	if child == replacement {
+		return
+	}
+
+	detach(replacement)
+
+	index := childIndex(parent, child)
+	if index == -1 {
+		return
+	}
+
+	parent.children[index] = replacement
+	replacement.parent = parent
+	child.parent = nil
+}
+
+// insertChildren adds the giving children into the parent at the giving
+// index.
+func insertChildren(parent *Markup, index int, children []*Markup) {
+	list := make([]*Markup, 0, len(children))
+	for _, child := range children {
+		if child != parent {
+			child.parent = parent
+			list = append(list, child)
+		}
+	}
+
+	if index > len(parent.children) {
+		index = len(parent.children)
+	}
+
+	rest := append(list, parent.children[index:]...)
+	parent.children = append(parent.children[:index], rest...)
+}
+
+// cloneMarkup returns a clone of the markup where the clone and all its
+// children receive new uids, so they can live in the same tree as the markup.
+func cloneMarkup(e *Markup) *Markup {
+	co := e.Clone()
+	gen := GetIDGenerator()
+
+	co.SwapUID(gen.UID(co))
+	co.UpdateHash()
+
+	co.EachChild(func(child *Markup) {
+		child.SwapUID(gen.UID(child))
+		child.UpdateHash()
+	})
+
+	return co
+}
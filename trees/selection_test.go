@@ -0,0 +1,237 @@
+package trees_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+func selectionFixture() *trees.Markup {
+	return trees.ParseAsRoot("section", `
+		<ul class="menu">
+			<li class="item active"><a href="/home">Home</a></li>
+			<li class="item"><a href="/posts">Posts</a></li>
+			<li class="item"><a href="/about">About</a></li>
+		</ul>
+		<p class="note">Footer</p>
+	`)
+}
+
+func names(selection *trees.Selection) string {
+	var list []string
+
+	selection.Each(func(_ int, item *trees.Markup) {
+		list = append(list, item.Name())
+	})
+
+	return strings.Join(list, " ")
+}
+
+// TestSelectionTraversal validates the traversal methods of a selection.
+func TestSelectionTraversal(t *testing.T) {
+	root := selectionFixture()
+
+	links := trees.Select(root, "a")
+	if links.Len() != 3 {
+		t.Fatalf("\t%s\t Should have selected all links: %d", failed, links.Len())
+	}
+	t.Logf("\t%s\t Should have selected all links", success)
+
+	if items := links.Closest("li"); items.Len() != 3 || names(items) != "li li li" {
+		t.Fatalf("\t%s\t Should have found the closest list items: %q", failed, names(items))
+	}
+	t.Logf("\t%s\t Should have found the closest list items", success)
+
+	if lists := links.Closest("ul"); lists.Len() != 1 {
+		t.Fatalf("\t%s\t Should have removed repeated ancestors: %d", failed, lists.Len())
+	}
+	t.Logf("\t%s\t Should have removed repeated ancestors", success)
+
+	active := trees.Select(root, "li.active")
+	if self := active.Closest(".item"); self.First() != active.First() {
+		t.Fatalf("\t%s\t Should have matched the element itself in Closest", failed)
+	}
+	t.Logf("\t%s\t Should have matched the element itself in Closest", success)
+
+	if parent := active.Parent(); parent.Len() != 1 || parent.First().Name() != "ul" {
+		t.Fatalf("\t%s\t Should have returned the parent list: %q", failed, names(parent))
+	}
+	t.Logf("\t%s\t Should have returned the parent list", success)
+
+	if siblings := active.Siblings(); siblings.Len() != 2 || siblings.Filter(".active").Len() != 0 {
+		t.Fatalf("\t%s\t Should have returned the siblings without the element: %d", failed, siblings.Len())
+	}
+	t.Logf("\t%s\t Should have returned the siblings without the element", success)
+
+	next := active.Next()
+	if next.Len() != 1 || next.Select("a").First().Children()[0].TextContent() != "Posts" {
+		t.Fatalf("\t%s\t Should have returned the next element", failed)
+	}
+	t.Logf("\t%s\t Should have returned the next element", success)
+
+	if prev := next.Prev(); prev.First() != active.First() {
+		t.Fatalf("\t%s\t Should have returned the previous element", failed)
+	}
+	t.Logf("\t%s\t Should have returned the previous element", success)
+
+	if last := trees.Select(root, "ul").Next(); last.Len() != 1 || last.First().Name() != "p" {
+		t.Fatalf("\t%s\t Should have skipped text nodes between elements: %q", failed, names(last))
+	}
+	t.Logf("\t%s\t Should have skipped text nodes between elements", success)
+
+	if none := trees.Select(root, "li:first-child").Prev(); none.Len() != 0 {
+		t.Fatalf("\t%s\t Should have returned an empty selection without siblings", failed)
+	}
+	t.Logf("\t%s\t Should have returned an empty selection without siblings", success)
+
+	if invalid := trees.Select(root, "li[").Filter("li"); invalid.Len() != 0 {
+		t.Fatalf("\t%s\t Should have returned an empty selection for invalid selectors", failed)
+	}
+	t.Logf("\t%s\t Should have returned an empty selection for invalid selectors", success)
+}
+
+// TestSelectionClasses validates adding and removing classes and attributes.
+func TestSelectionClasses(t *testing.T) {
+	root := selectionFixture()
+
+	items := trees.Select(root, "li")
+	items.AddClass("entry", "item").RemoveClass("active")
+
+	if trees.Select(root, "li.entry.item").Len() != 3 || trees.Select(root, ".active").Len() != 0 {
+		t.Fatalf("\t%s\t Should have updated the classes of the elements", failed)
+	}
+	t.Logf("\t%s\t Should have updated the classes of the elements", success)
+
+	if html := items.First().HTML(); strings.Count(html, "item") != 1 {
+		t.Fatalf("\t%s\t Should not have repeated existing classes: %q", failed, html)
+	}
+	t.Logf("\t%s\t Should not have repeated existing classes", success)
+
+	trees.Select(root, "a").SetAttr("target", "_blank").SetAttr("href", "#")
+	if trees.Select(root, `a[target="_blank"][href="#"]`).Len() != 3 {
+		t.Fatalf("\t%s\t Should have set the attributes of the elements", failed)
+	}
+	t.Logf("\t%s\t Should have set the attributes of the elements", success)
+}
+
+// TestSelectionMutation validates the structural changes of a selection keep
+// the parents of the elements consistent.
+func TestSelectionMutation(t *testing.T) {
+	root := selectionFixture()
+
+	links := trees.Select(root, "a")
+	links.Wrap(trees.NewMarkup("span", false))
+
+	wrappers := trees.Select(root, "li > span > a")
+	if wrappers.Len() != 3 {
+		t.Fatalf("\t%s\t Should have wrapped every link: %d", failed, wrappers.Len())
+	}
+	t.Logf("\t%s\t Should have wrapped every link", success)
+
+	uids := make(map[string]bool)
+	trees.Select(root, "span").Each(func(_ int, item *trees.Markup) {
+		uids[item.UID()] = true
+	})
+
+	if len(uids) != 3 {
+		t.Fatalf("\t%s\t Should have given every wrapper its own uid", failed)
+	}
+	t.Logf("\t%s\t Should have given every wrapper its own uid", success)
+
+	note := trees.Select(root, "p.note")
+	note.InsertBefore(trees.NewMarkup("hr", true))
+	note.InsertAfter(trees.NewMarkup("footer", false))
+
+	if prev := note.Prev(); prev.First() == nil || prev.First().Name() != "hr" {
+		t.Fatalf("\t%s\t Should have inserted the markup before the element", failed)
+	}
+	t.Logf("\t%s\t Should have inserted the markup before the element", success)
+
+	if next := note.Next(); next.First() == nil || next.First().Name() != "footer" {
+		t.Fatalf("\t%s\t Should have inserted the markup after the element", failed)
+	}
+	t.Logf("\t%s\t Should have inserted the markup after the element", success)
+
+	// moving an element into a new position must remove it from the old one.
+	list := trees.Select(root, "ul")
+	note.InsertBefore(list.First())
+
+	if len(root.Children()) != 4 || trees.Select(root, "ul").Next().First() != note.First() {
+		t.Fatalf("\t%s\t Should have moved the element to its new position: %q", failed, names(trees.SelectMarkup(root.Children()...).Filter("*")))
+	}
+	t.Logf("\t%s\t Should have moved the element to its new position", success)
+
+	replaced := trees.Select(root, "li").ReplaceWith(trees.NewMarkup("li", false))
+	if replaced.Len() != 3 || trees.Select(root, "a").Len() != 0 {
+		t.Fatalf("\t%s\t Should have replaced every element", failed)
+	}
+	t.Logf("\t%s\t Should have replaced every element", success)
+
+	var broken bool
+	root.EachChild(func(child *trees.Markup) {
+		for _, item := range child.Children() {
+			if trees.SelectMarkup(item).Parent().First() != child && item.Kind() == trees.ElementNode {
+				broken = true
+			}
+		}
+	})
+
+	if broken {
+		t.Fatalf("\t%s\t Should have kept the parents of all elements", failed)
+	}
+	t.Logf("\t%s\t Should have kept the parents of all elements", success)
+}
+
+// TestSelectionReconcile validates changes made through a selection are
+// reported by Reconcile against the unchanged tree.
+func TestSelectionReconcile(t *testing.T) {
+	old := selectionFixture()
+
+	unchanged := old.Clone()
+	if unchanged.Reconcile(old) {
+		t.Fatalf("\t%s\t Should not have reported changes for a clone", failed)
+	}
+	t.Logf("\t%s\t Should not have reported changes for a clone", success)
+
+	old = selectionFixture()
+	changed := old.Clone()
+	trees.Select(changed, "li.active").AddClass("current")
+
+	hash := changed.Hash()
+	if !changed.Reconcile(old) {
+		t.Fatalf("\t%s\t Should have reported the changed classes", failed)
+	}
+	t.Logf("\t%s\t Should have reported the changed classes", success)
+
+	if changed.Hash() != hash || changed.Hash() == old.Hash() {
+		t.Fatalf("\t%s\t Should have kept the updated hash of the changed tree", failed)
+	}
+	t.Logf("\t%s\t Should have kept the updated hash of the changed tree", success)
+
+	untouched := trees.Select(changed, "p.note").First()
+	if untouched.Hash() != trees.Select(old, "p.note").First().Hash() {
+		t.Fatalf("\t%s\t Should have kept the hash of unchanged elements", failed)
+	}
+	t.Logf("\t%s\t Should have kept the hash of unchanged elements", success)
+}
+
+// TestSelectionSetText validates replacing the children of elements by text.
+func TestSelectionSetText(t *testing.T) {
+	root := selectionFixture()
+	hash := root.Hash()
+
+	trees.Select(root, "li").SetText("Item")
+
+	if trees.Select(root, "a").Len() != 0 || root.Hash() == hash {
+		t.Fatalf("\t%s\t Should have replaced the children of the elements", failed)
+	}
+	t.Logf("\t%s\t Should have replaced the children of the elements", success)
+
+	for _, item := range trees.Select(root, "li").Markup() {
+		if len(item.Children()) != 1 || item.Children()[0].TextContent() != "Item" {
+			t.Fatalf("\t%s\t Should have added the text into the elements", failed)
+		}
+	}
+	t.Logf("\t%s\t Should have added the text into the elements", success)
+}
@@ -0,0 +1,794 @@
+package trees
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//==============================================================================
+
+// maxCachedSelectors defines the number of compiled selectors kept by
+// CompileSelector before its cache is reset.
+const maxCachedSelectors = 1024
+
+// selectorCache holds the selectors compiled by CompileSelector.
+var selectorCache = struct {
+	ml    sync.RWMutex
+	cache map[string]*CompiledSelector
+}{
+	cache: make(map[string]*CompiledSelector),
+}
+
+// CompiledSelector defines a css selector list compiled for matching against
+// markup. It supports type, universal, id, class and attribute selectors with
+// the '=', '~=', '|=', '^=', '$=' and '*=' operators, the descendant, child
+// ('>'), adjacent sibling ('+') and general sibling ('~') combinators and the
+// ':not()', ':nth-child()', ':nth-last-child()', ':nth-of-type()',
+// ':nth-last-of-type()', ':first-child', ':last-child', ':only-child',
+// ':first-of-type', ':last-of-type', ':only-of-type', ':empty' and ':root'
+// pseudo-classes.
+type CompiledSelector struct {
+	source    string
+	selectors []complexSelector
+}
+
+// CompileSelector returns the giving css selector list compiled for matching
+// against markup, else returns an error if the selector is invalid. Compiled
+// selectors are cached, so compiling the same selector again is cheap.
+func CompileSelector(sel string) (*CompiledSelector, error) {
+	selectorCache.ml.RLock()
+	compiled, ok := selectorCache.cache[sel]
+	selectorCache.ml.RUnlock()
+
+	if ok {
+		return compiled, nil
+	}
+
+	parser := selectorParser{source: sel}
+
+	selectors, err := parser.parseList()
+	if err != nil {
+		return nil, err
+	}
+
+	if !parser.done() {
+		return nil, parser.fail("unexpected %q", parser.source[parser.pos])
+	}
+
+	compiled = &CompiledSelector{source: sel, selectors: selectors}
+
+	selectorCache.ml.Lock()
+	if len(selectorCache.cache) >= maxCachedSelectors {
+		selectorCache.cache = make(map[string]*CompiledSelector)
+	}
+	selectorCache.cache[sel] = compiled
+	selectorCache.ml.Unlock()
+
+	return compiled, nil
+}
+
+// String returns the source of the selector.
+func (c *CompiledSelector) String() string {
+	return c.source
+}
+
+// Match returns true/false if the giving markup matches the selector.
+func (c *CompiledSelector) Match(e *Markup) bool {
+	if e == nil || e.Kind() != ElementNode {
+		return false
+	}
+
+	for _, sel := range c.selectors {
+		if sel.matchAt(e, len(sel.parts)-1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Query returns the first element within the root, in document order, which
+// matches the selector.
+func (c *CompiledSelector) Query(root *Markup) *Markup {
+	var found *Markup
+
+	walkElements(root, func(e *Markup) bool {
+		if c.Match(e) {
+			found = e
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// QueryAll returns all elements within the root, in document order, which
+// match the selector.
+func (c *CompiledSelector) QueryAll(root *Markup) []*Markup {
+	var found []*Markup
+
+	walkElements(root, func(e *Markup) bool {
+		if c.Match(e) {
+			found = append(found, e)
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// walkElements calls the giving function with the descendant elements of the
+// root in document order, until it returns false. It returns false if the
+// walk was stopped.
+func walkElements(root *Markup, fn func(*Markup) bool) bool {
+	if root == nil {
+		return true
+	}
+
+	for _, child := range root.children {
+		if child.Kind() != ElementNode {
+			continue
+		}
+
+		if !fn(child) || !walkElements(child, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//==============================================================================
+
+// complexSelector defines a sequence of compound selectors joined by
+// combinators.
+type complexSelector struct {
+	parts []selectorPart
+}
+
+// selectorPart defines a compound selector and the combinator joining it to
+// the part on its left.
+type selectorPart struct {
+	combinator byte
+	compound   compoundSelector
+}
+
+// matchAt returns true/false if the element matches the part at the giving
+// index and the element related to it through the combinator of the part
+// matches the parts on its left.
+func (c complexSelector) matchAt(e *Markup, index int) bool {
+	part := c.parts[index]
+	if !part.compound.match(e) {
+		return false
+	}
+
+	if index == 0 {
+		return true
+	}
+
+	switch part.combinator {
+	case '>':
+		parent := parentElement(e)
+		return parent != nil && c.matchAt(parent, index-1)
+
+	case '+':
+		sibling := previousElement(e)
+		return sibling != nil && c.matchAt(sibling, index-1)
+
+	case '~':
+		for sibling := previousElement(e); sibling != nil; sibling = previousElement(sibling) {
+			if c.matchAt(sibling, index-1) {
+				return true
+			}
+		}
+
+	default:
+		for parent := parentElement(e); parent != nil; parent = parentElement(parent) {
+			if c.matchAt(parent, index-1) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// compoundSelector defines a sequence of simple selectors which must all
+// match an element.
+type compoundSelector struct {
+	tag     string
+	ids     []string
+	classes []string
+	attrs   []attrSelector
+	pseudos []func(*Markup) bool
+}
+
+// match returns true/false if the element matches all the simple selectors.
+func (c compoundSelector) match(e *Markup) bool {
+	if c.tag != "" && c.tag != "*" {
+		if e.namespace == "" && !strings.EqualFold(c.tag, e.tagname) || e.namespace != "" && c.tag != e.tagname {
+			return false
+		}
+	}
+
+	for _, id := range c.ids {
+		if value, ok := attrValue(e, "id"); !ok || value != id {
+			return false
+		}
+	}
+
+	if len(c.classes) != 0 {
+		value, _ := attrValue(e, "class")
+		classes := strings.Fields(value)
+
+		for _, class := range c.classes {
+			if !containsWord(classes, class) {
+				return false
+			}
+		}
+	}
+
+	for _, attr := range c.attrs {
+		if !attr.match(e) {
+			return false
+		}
+	}
+
+	for _, pseudo := range c.pseudos {
+		if !pseudo(e) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// attrSelector defines a selector for an attribute.
+type attrSelector struct {
+	name  string
+	op    string
+	value string
+	fold  bool
+}
+
+// match returns true/false if the element has the attribute with a value
+// matching the selector.
+func (a attrSelector) match(e *Markup) bool {
+	val, ok := attrValue(e, a.name)
+	if !ok {
+		return false
+	}
+
+	want := a.value
+	if a.fold {
+		val, want = strings.ToLower(val), strings.ToLower(want)
+	}
+
+	switch a.op {
+	case "":
+		return true
+	case exactMatch:
+		return val == want
+	case exactWordInListMatch:
+		return want != "" && containsWord(strings.Fields(val), want)
+	case beginOrExactlyMatch:
+		return val == want || strings.HasPrefix(val, want+"-")
+	case prefixMatch:
+		return want != "" && strings.HasPrefix(val, want)
+	case suffixMatch:
+		return want != "" && strings.HasSuffix(val, want)
+	case containsMatch:
+		return want != "" && strings.Contains(val, want)
+	}
+
+	return false
+}
+
+// attrValue returns the value of the attribute of the element with the giving
+// name, where the values of multiple 'class' attributes are joined. The uid of
+// the element is returned for 'uid', as written out by the printers, so the
+// selectors of events match the same elements as they do in the DOM.
+func attrValue(e *Markup, name string) (string, bool) {
+	var values []string
+
+	for _, attr := range e.attrs {
+		key, value := attr.Render()
+		if !strings.EqualFold(key, name) {
+			continue
+		}
+
+		if name != "class" {
+			return value, true
+		}
+
+		values = append(values, value)
+	}
+
+	if name == "uid" {
+		return e.uid, true
+	}
+
+	return strings.Join(values, " "), len(values) != 0
+}
+
+func containsWord(words []string, word string) bool {
+	for _, item := range words {
+		if item == word {
+			return true
+		}
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// parentElement returns the parent of the element, if any.
+func parentElement(e *Markup) *Markup {
+	if e.parent == nil || e.parent.Kind() != ElementNode {
+		return nil
+	}
+
+	return e.parent
+}
+
+// previousElement returns the element preceding the element within its parent.
+func previousElement(e *Markup) *Markup {
+	if e.parent == nil {
+		return nil
+	}
+
+	var previous *Markup
+	for _, child := range e.parent.children {
+		if child == e {
+			return previous
+		}
+
+		if child.Kind() == ElementNode {
+			previous = child
+		}
+	}
+
+	return nil
+}
+
+// siblingPosition returns the 1-based position of the element among its
+// element siblings and their count, counting only those of the same tag name
+// if ofType is true.
+func siblingPosition(e *Markup, ofType bool, fromEnd bool) int {
+	if e.parent == nil {
+		return 1
+	}
+
+	var position, count int
+
+	for _, child := range e.parent.children {
+		if child.Kind() != ElementNode || ofType && child.tagname != e.tagname {
+			continue
+		}
+
+		count++
+
+		if child == e {
+			position = count
+		}
+	}
+
+	if fromEnd {
+		return count - position + 1
+	}
+
+	return position
+}
+
+// nthMatcher returns a function which matches elements whose sibling position
+// is a*n+b for some n >= 0.
+func nthMatcher(a int, b int, ofType bool, fromEnd bool) func(*Markup) bool {
+	return func(e *Markup) bool {
+		position := siblingPosition(e, ofType, fromEnd)
+
+		if a == 0 {
+			return position == b
+		}
+
+		n := position - b
+		return n%a == 0 && n/a >= 0
+	}
+}
+
+// simplePseudos defines the pseudo-classes which take no arguments.
+var simplePseudos = map[string]func(*Markup) bool{
+	"first-child":   nthMatcher(0, 1, false, false),
+	"last-child":    nthMatcher(0, 1, false, true),
+	"first-of-type": nthMatcher(0, 1, true, false),
+	"last-of-type":  nthMatcher(0, 1, true, true),
+	"only-child": func(e *Markup) bool {
+		return siblingPosition(e, false, false) == 1 && siblingPosition(e, false, true) == 1
+	},
+	"only-of-type": func(e *Markup) bool {
+		return siblingPosition(e, true, false) == 1 && siblingPosition(e, true, true) == 1
+	},
+	"root": func(e *Markup) bool {
+		return parentElement(e) == nil
+	},
+	"empty": func(e *Markup) bool {
+		if e.TextContent() != "" {
+			return false
+		}
+
+		for _, child := range e.children {
+			switch child.Kind() {
+			case ElementNode:
+				return false
+			case TextNode:
+				if child.TextContent() != "" {
+					return false
+				}
+			}
+		}
+
+		return true
+	},
+}
+
+//==============================================================================
+
+// selectorParser parses css selectors into their compiled form.
+type selectorParser struct {
+	source string
+	pos    int
+}
+
+// fail returns an error for the current position of the parser.
+func (p *selectorParser) fail(message string, args ...interface{}) error {
+	return fmt.Errorf("%s: %q at %d: %s", ErrInvalidSelector, p.source, p.pos, fmt.Sprintf(message, args...))
+}
+
+func (p *selectorParser) done() bool {
+	return p.pos >= len(p.source)
+}
+
+func (p *selectorParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+
+	return p.source[p.pos]
+}
+
+// skipSpace skips whitespace, returning true if any was skipped.
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+
+	for !p.done() && strings.IndexByte(" \t\n\r\f", p.peek()) != -1 {
+		p.pos++
+	}
+
+	return p.pos > start
+}
+
+// parseList parses a comma separated list of complex selectors, stopping at
+// the end of the source or a closing parenthesis.
+func (p *selectorParser) parseList() ([]complexSelector, error) {
+	var list []complexSelector
+
+	for {
+		p.skipSpace()
+
+		sel, err := p.parseComplex()
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, sel)
+
+		p.skipSpace()
+
+		if p.peek() != ',' {
+			return list, nil
+		}
+
+		p.pos++
+	}
+}
+
+// parseComplex parses compound selectors joined by combinators.
+func (p *selectorParser) parseComplex() (complexSelector, error) {
+	var sel complexSelector
+	var combinator byte = ' '
+
+	for {
+		compound, err := p.parseCompound()
+		if err != nil {
+			return sel, err
+		}
+
+		sel.parts = append(sel.parts, selectorPart{combinator: combinator, compound: compound})
+
+		spaced := p.skipSpace()
+
+		switch next := p.peek(); next {
+		case '>', '+', '~':
+			p.pos++
+			p.skipSpace()
+			combinator = next
+		case 0, ',', ')':
+			return sel, nil
+		default:
+			if !spaced {
+				return sel, p.fail("unexpected %q", next)
+			}
+
+			combinator = ' '
+		}
+	}
+}
+
+// parseCompound parses a sequence of simple selectors.
+func (p *selectorParser) parseCompound() (compoundSelector, error) {
+	var compound compoundSelector
+	var empty = true
+
+	if p.peek() == '*' {
+		p.pos++
+		compound.tag = "*"
+		empty = false
+	} else if name := p.parseIdent(); name != "" {
+		compound.tag = name
+		empty = false
+	}
+
+	for {
+		switch p.peek() {
+		case '#':
+			p.pos++
+
+			id := p.parseIdent()
+			if id == "" {
+				return compound, p.fail("expected id")
+			}
+
+			compound.ids = append(compound.ids, id)
+
+		case '.':
+			p.pos++
+
+			class := p.parseIdent()
+			if class == "" {
+				return compound, p.fail("expected class")
+			}
+
+			compound.classes = append(compound.classes, class)
+
+		case '[':
+			p.pos++
+
+			attr, err := p.parseAttr()
+			if err != nil {
+				return compound, err
+			}
+
+			compound.attrs = append(compound.attrs, attr)
+
+		case ':':
+			p.pos++
+
+			pseudo, err := p.parsePseudo()
+			if err != nil {
+				return compound, err
+			}
+
+			compound.pseudos = append(compound.pseudos, pseudo)
+
+		default:
+			if empty {
+				return compound, p.fail("expected selector")
+			}
+
+			return compound, nil
+		}
+
+		empty = false
+	}
+}
+
+// parseIdent parses a css identifier, handling backslash escapes.
+func (p *selectorParser) parseIdent() string {
+	var ident []byte
+
+	for !p.done() {
+		c := p.peek()
+
+		switch {
+		case c == '\\' && p.pos+1 < len(p.source):
+			ident = append(ident, p.source[p.pos+1])
+			p.pos += 2
+			continue
+		case c == '-' || c == '_' || c >= 0x80,
+			c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z',
+			c >= '0' && c <= '9' && len(ident) != 0:
+			ident = append(ident, c)
+			p.pos++
+			continue
+		}
+
+		break
+	}
+
+	return string(ident)
+}
+
+// parseAttr parses an attribute selector after its opening bracket.
+func (p *selectorParser) parseAttr() (attrSelector, error) {
+	var attr attrSelector
+
+	p.skipSpace()
+
+	if attr.name = p.parseIdent(); attr.name == "" {
+		return attr, p.fail("expected attribute name")
+	}
+
+	p.skipSpace()
+
+	if p.peek() == ']' {
+		p.pos++
+		return attr, nil
+	}
+
+	for _, op := range []string{exactWordInListMatch, beginOrExactlyMatch, prefixMatch, suffixMatch, containsMatch, exactMatch} {
+		if strings.HasPrefix(p.source[p.pos:], op) {
+			attr.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+
+	if attr.op == "" {
+		return attr, p.fail("expected attribute operator")
+	}
+
+	p.skipSpace()
+
+	switch quote := p.peek(); quote {
+	case '"', '\'':
+		end := strings.IndexByte(p.source[p.pos+1:], quote)
+		if end == -1 {
+			return attr, p.fail("unterminated string")
+		}
+
+		attr.value = p.source[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+	default:
+		start := p.pos
+		for !p.done() && p.peek() != ']' && strings.IndexByte(" \t\n\r\f", p.peek()) == -1 {
+			p.pos++
+		}
+
+		attr.value = p.source[start:p.pos]
+	}
+
+	p.skipSpace()
+
+	if flag := p.peek(); flag == 'i' || flag == 'I' {
+		attr.fold = true
+		p.pos++
+		p.skipSpace()
+	}
+
+	if p.peek() != ']' {
+		return attr, p.fail("expected ']'")
+	}
+
+	p.pos++
+	return attr, nil
+}
+
+// parsePseudo parses a pseudo-class after its colon.
+func (p *selectorParser) parsePseudo() (func(*Markup) bool, error) {
+	if p.peek() == ':' {
+		return nil, p.fail("pseudo-elements never match markup")
+	}
+
+	name := strings.ToLower(p.parseIdent())
+
+	if pseudo, ok := simplePseudos[name]; ok {
+		return pseudo, nil
+	}
+
+	if p.peek() != '(' {
+		return nil, p.fail("unknown pseudo-class %q", name)
+	}
+
+	p.pos++
+
+	switch name {
+	case "not":
+		list, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek() != ')' {
+			return nil, p.fail("expected ')'")
+		}
+
+		p.pos++
+
+		negated := CompiledSelector{selectors: list}
+		return func(e *Markup) bool {
+			return !negated.Match(e)
+		}, nil
+
+	case "nth-child", "nth-last-child", "nth-of-type", "nth-last-of-type":
+		end := strings.IndexByte(p.source[p.pos:], ')')
+		if end == -1 {
+			return nil, p.fail("expected ')'")
+		}
+
+		a, b, err := parseNth(p.source[p.pos : p.pos+end])
+		if err != nil {
+			return nil, p.fail("%s", err)
+		}
+
+		p.pos += end + 1
+
+		return nthMatcher(a, b, strings.HasSuffix(name, "of-type"), strings.HasPrefix(name, "nth-last")), nil
+	}
+
+	return nil, p.fail("unknown pseudo-class %q", name)
+}
+
+// parseNth parses the an+b notation of the nth pseudo-classes.
+func parseNth(expr string) (int, int, error) {
+	expr = strings.ToLower(strings.Join(strings.Fields(expr), ""))
+
+	switch expr {
+	case "odd":
+		return 2, 1, nil
+	case "even":
+		return 2, 0, nil
+	}
+
+	n := strings.IndexByte(expr, 'n')
+	if n == -1 {
+		b, err := strconv.Atoi(expr)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", expr)
+		}
+
+		return 0, b, nil
+	}
+
+	var a, b int
+	var err error
+
+	switch coefficient := expr[:n]; coefficient {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		if a, err = strconv.Atoi(coefficient); err != nil {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", expr)
+		}
+	}
+
+	if offset := expr[n+1:]; offset != "" {
+		if offset[0] != '+' && offset[0] != '-' {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", expr)
+		}
+
+		if b, err = strconv.Atoi(offset); err != nil {
+			return 0, 0, fmt.Errorf("invalid nth expression %q", expr)
+		}
+	}
+
+	return a, b, nil
+}
+
+//==============================================================================
@@ -0,0 +1,274 @@
+package trees
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+//==============================================================================
+
+// StreamChunk defines the size in bytes which the buffer of a streaming write
+// is allowed to grow to before it gets written into the underline writer.
+const StreamChunk = 32 * 1024
+
+// Flusher defines an interface for writers which can push out data which they
+// have buffered, as done by http.ResponseWriter through http.Flusher.
+type Flusher interface {
+	Flush()
+}
+
+// errorFlusher defines an interface for writers whose flush can fail, as done
+// by bufio.Writer.
+type errorFlusher interface {
+	Flush() error
+}
+
+// bufferPool provides the buffers used by streaming writes.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// getBuffer returns a reset buffer from the pool.
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+// putBuffer returns the buffer into the pool, dropping buffers which have grown
+// too large to be worth keeping around.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > 4*StreamChunk {
+		return
+	}
+
+	bufferPool.Put(buf)
+}
+
+//==============================================================================
+
+// WriteTo writes the html representation of the giving *Markup into the
+// provided writer, producing the same output as Print without building the
+// whole document in memory. Output is buffered in chunks of StreamChunk bytes
+// and written out once the closing tag of a 'head' element is written, which
+// also gets flushed if the writer is a Flusher, so browsers can start fetching
+// the assets of a page before its body arrives.
+func (m *ElementWriter) WriteTo(w io.Writer, e *Markup) (int64, error) {
+	sw := stream{
+		w:    w,
+		buf:  getBuffer(),
+		mode: GetMode(),
+		el:   m,
+	}
+
+	defer putBuffer(sw.buf)
+
+	sw.markup(e)
+	sw.flush(false)
+
+	return sw.n, sw.err
+}
+
+// writeString writes the html representation of the giving *Markup into the
+// provided buffer.
+func (m *ElementWriter) writeString(buf *bytes.Buffer, e *Markup) {
+	sw := stream{
+		buf:  buf,
+		mode: GetMode(),
+		el:   m,
+	}
+
+	sw.markup(e)
+}
+
+// stream implements the streaming write of a *Markup for an ElementWriter.
+// When no writer is set, all output is left in the buffer.
+type stream struct {
+	w    io.Writer
+	buf  *bytes.Buffer
+	mode Mode
+	el   *ElementWriter
+	n    int64
+	err  error
+}
+
+// markup writes the giving markup and its children into the buffer.
+func (s *stream) markup(e *Markup) {
+	if s.err != nil {
+		return
+	}
+
+	if e.Removed() && s.mode > Normal {
+		return
+	}
+
+	switch e.Kind() {
+	case TextNode:
+		//if we are dealing with a text type just write the content
+		s.buf.WriteString(s.el.text.Print(e))
+		s.fill()
+		return
+	case CommentNode:
+		s.buf.WriteString("<!--")
+		s.buf.WriteString(escapeComment(e.TextContent()))
+		s.buf.WriteString("-->")
+		return
+	case DoctypeNode:
+		s.buf.WriteString("<!DOCTYPE ")
+		s.buf.WriteString(escapeDoctype(e.TextContent()))
+		s.buf.WriteByte('>')
+		return
+	}
+
+	s.buf.WriteByte('<')
+	s.buf.WriteString(e.Name())
+
+	if s.mode < Pretty {
+		s.attrs([]Property{
+			&Attribute{Name: "hash", Value: e.Hash()},
+			&Attribute{Name: "uid", Value: e.UID()},
+		})
+	}
+
+	s.attrs(e.Attributes())
+	s.styles(e.Styles())
+
+	if !e.AutoClosed() {
+		s.buf.WriteByte('>')
+	}
+
+	s.buf.WriteString(leadingNewline(e))
+	s.buf.WriteString(escapeText(e, e.TextContent()))
+	s.fill()
+
+	for _, ch := range e.Children() {
+		if ch.UID() == e.UID() {
+			continue
+		}
+
+		s.markup(ch)
+	}
+
+	if e.AutoClosed() {
+		s.buf.WriteString("/>")
+		return
+	}
+
+	s.buf.WriteString("</")
+	s.buf.WriteString(e.Name())
+	s.buf.WriteByte('>')
+
+	if e.Name() == "head" {
+		s.flush(true)
+		return
+	}
+
+	s.fill()
+}
+
+// attrs writes the giving attributes into the buffer, using the attribute
+// printer of the ElementWriter unless it's the default AttrWriter.
+func (s *stream) attrs(a []Property) {
+	if _, ok := s.el.attrWriter.(AttrWriter); !ok {
+		s.buf.WriteString(s.el.attrWriter.Print(a))
+		return
+	}
+
+	var written int
+
+	for _, ar := range a {
+		name, val := ar.Render()
+		if !validAttrName(name) || scriptAttr(name) {
+			continue
+		}
+
+		if written > 0 {
+			s.buf.WriteByte(' ')
+		}
+
+		written++
+
+		s.buf.WriteByte(' ')
+		s.buf.WriteString(name)
+		s.buf.WriteString(`="`)
+		s.buf.WriteString(escapeAttr(name, val))
+		s.buf.WriteByte('"')
+	}
+}
+
+// styles writes the giving styles as the escaped style attribute into the
+// buffer, using the style printer of the ElementWriter unless it's the
+// default StyleWriter.
+func (s *stream) styles(st []Property) {
+	s.buf.WriteString(` style="`)
+	defer s.buf.WriteByte('"')
+
+	if _, ok := s.el.styleWriter.(StyleWriter); !ok {
+		attrEscaper.WriteString(s.buf, s.el.styleWriter.Print(st))
+		return
+	}
+
+	css := getBuffer()
+	defer putBuffer(css)
+
+	for _, cs := range st {
+		name, val := cs.Render()
+		if !validCSSName.MatchString(name) {
+			continue
+		}
+
+		if css.Len() > 0 {
+			css.WriteByte(' ')
+		}
+
+		css.WriteByte(' ')
+		css.WriteString(name)
+		css.WriteByte(':')
+		css.WriteString(escapeStyle(val))
+		css.WriteByte(';')
+	}
+
+	attrEscaper.WriteString(s.buf, css.String())
+}
+
+// fill writes out the buffer once it has grown past StreamChunk.
+func (s *stream) fill() {
+	if s.buf.Len() >= StreamChunk {
+		s.flush(false)
+	}
+}
+
+// flush writes out the content of the buffer into the writer, flushing the
+// writer as well if requested and supported.
+func (s *stream) flush(push bool) {
+	if s.w == nil || s.err != nil {
+		return
+	}
+
+	if s.buf.Len() > 0 {
+		n, err := s.w.Write(s.buf.Bytes())
+		s.n += int64(n)
+		s.buf.Reset()
+
+		if err != nil {
+			s.err = err
+			return
+		}
+	}
+
+	if !push {
+		return
+	}
+
+	switch fl := s.w.(type) {
+	case Flusher:
+		fl.Flush()
+	case errorFlusher:
+		s.err = fl.Flush()
+	}
+}
+
+//==============================================================================
@@ -0,0 +1,162 @@
+package trees_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// flushRecorder records the content written into it at each flush.
+type flushRecorder struct {
+	bytes.Buffer
+	flushes []string
+}
+
+func (f *flushRecorder) Flush() {
+	f.flushes = append(f.flushes, f.String())
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func generatePage(items int) *trees.Markup {
+	html := trees.NewMarkup("html", false)
+
+	head := trees.NewMarkup("head", false)
+	link := trees.NewMarkup("link", true)
+	trees.NewAttr("rel", "stylesheet").Apply(link)
+	trees.NewAttr("href", "/app.css").Apply(link)
+	link.Apply(head)
+	head.Apply(html)
+
+	body := trees.NewMarkup("body", false)
+	trees.NewCSSStyle("width", "auto").Apply(body)
+	trees.NewCSSStyle("font-family", `"Open Sans"`).Apply(body)
+
+	list := trees.NewMarkup("ul", false)
+	for i := 0; i < items; i++ {
+		item := trees.NewMarkup("li", false)
+		trees.NewAttr("class", "item").Apply(item)
+		trees.NewAttr("data-index", fmt.Sprintf("%d", i)).Apply(item)
+		trees.NewText(fmt.Sprintf("Item %d", i)).Apply(item)
+		item.Apply(list)
+	}
+
+	list.Apply(body)
+	body.Apply(html)
+
+	return html
+}
+
+// TestWriteTo validates the output streamed by the ElementWriter matches its
+// printed output.
+func TestWriteTo(t *testing.T) {
+	defer trees.SetMode(trees.GetMode())
+
+	for _, mode := range []trees.Mode{trees.Normal, trees.Pretty} {
+		trees.SetMode(mode)
+
+		for _, markup := range []*trees.Markup{generateMarkup(), generatePage(2000)} {
+			var out bytes.Buffer
+
+			n, err := trees.SimpleElementWriter.WriteTo(&out, markup)
+			if err != nil {
+				t.Fatalf("\t%s\t Should have streamed markup without error: %q", failed, err)
+			}
+			t.Logf("\t%s\t Should have streamed markup without error", success)
+
+			if out.String() != trees.SimpleElementWriter.Print(markup) {
+				t.Fatalf("\t%s\t Should have streamed the same output as printed in mode %d", failed, mode)
+			}
+			t.Logf("\t%s\t Should have streamed the same output as printed in mode %d", success, mode)
+
+			if n != int64(out.Len()) {
+				t.Fatalf("\t%s\t Should have reported the number of bytes written: %d", failed, n)
+			}
+			t.Logf("\t%s\t Should have reported the number of bytes written", success)
+
+			if markup.HTML() != out.String() {
+				t.Fatalf("\t%s\t Should have produced the same output from markup html", failed)
+			}
+			t.Logf("\t%s\t Should have produced the same output from markup html", success)
+		}
+	}
+}
+
+// TestWriteToFlush validates the head of a page gets flushed once written.
+func TestWriteToFlush(t *testing.T) {
+	var out flushRecorder
+
+	if _, err := trees.SimpleElementWriter.WriteTo(&out, generatePage(10)); err != nil {
+		t.Fatalf("\t%s\t Should have streamed markup without error: %q", failed, err)
+	}
+	t.Logf("\t%s\t Should have streamed markup without error", success)
+
+	if len(out.flushes) != 1 || !strings.HasSuffix(out.flushes[0], "</head>") {
+		t.Fatalf("\t%s\t Should have flushed once the head was written: %q", failed, out.flushes)
+	}
+	t.Logf("\t%s\t Should have flushed once the head was written", success)
+}
+
+// TestPrintBaseline validates the streaming writer prints the same markup as
+// the baseline of BenchmarkPrintBaseline.
+func TestPrintBaseline(t *testing.T) {
+	page := generatePage(10)
+
+	if trees.SimpleElementWriter.Print(page) != trees.SimpleElementWriter.PrintBaseline(page) {
+		t.Fatalf("\t%s\t Should have printed the same markup as the baseline", failed)
+	}
+	t.Logf("\t%s\t Should have printed the same markup as the baseline", success)
+}
+
+// TestWriteToError validates the failure of the writer is returned.
+func TestWriteToError(t *testing.T) {
+	if _, err := trees.SimpleElementWriter.WriteTo(failingWriter{}, generatePage(10)); err == nil {
+		t.Fatalf("\t%s\t Should have returned the error of the writer", failed)
+	}
+	t.Logf("\t%s\t Should have returned the error of the writer", success)
+}
+
+func BenchmarkPrint(b *testing.B) {
+	page := generatePage(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ioutil.Discard.Write([]byte(trees.SimpleElementWriter.Print(page)))
+	}
+}
+
+// BenchmarkPrintBaseline measures Print as it was before writing through the
+// streaming writer, the baseline for BenchmarkPrint and BenchmarkWriteTo.
+func BenchmarkPrintBaseline(b *testing.B) {
+	page := generatePage(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ioutil.Discard.Write([]byte(trees.SimpleElementWriter.PrintBaseline(page)))
+	}
+}
+
+func BenchmarkWriteTo(b *testing.B) {
+	page := generatePage(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		trees.SimpleElementWriter.WriteTo(ioutil.Discard, page)
+	}
+}
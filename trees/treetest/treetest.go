@@ -0,0 +1,381 @@
+// Package treetest provides helpers for testing trees markup by structure
+// rather than by its printed html, ignoring the management attributes added by
+// the printers, the order of attributes, classes and styles and whitespace
+// between elements, along with golden-file snapshots of markup.
+package treetest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// update sets the snapshots to be written out rather than compared against.
+var update = flag.Bool("treetest.update", false, "write out the golden files of treetest snapshots")
+
+// ManagementAttributes defines the attributes which are ignored when markup is
+// compared, as they are added by the printers or change on every render.
+var ManagementAttributes = map[string]bool{
+	"data-gen": true,
+	"hash":     true,
+	"uid":      true,
+}
+
+// preformatted defines the elements whose text keeps its whitespace.
+var preformatted = map[string]bool{
+	"listing":  true,
+	"pre":      true,
+	"script":   true,
+	"style":    true,
+	"textarea": true,
+	"xmp":      true,
+}
+
+// Canonical returns the canonical form of the giving markup, which writes
+// every node on its own line indented by its depth, with attributes, classes
+// and styles sorted, management attributes removed and text with collapsed
+// whitespace. Markup which is structurally equal has the same canonical form.
+func Canonical(markup ...*trees.Markup) string {
+	var out bytes.Buffer
+
+	for _, item := range markup {
+		writeCanonical(&out, item, 0, false)
+	}
+
+	return out.String()
+}
+
+// Equal returns true/false if the giving markup are structurally equal.
+func Equal(expected *trees.Markup, actual *trees.Markup) bool {
+	return Diff(expected, actual) == ""
+}
+
+// Diff returns a readable difference between the giving markup, showing the
+// path to the first subtree which does not match and the lines of its
+// canonical form which differ. An empty string is returned if both are
+// structurally equal.
+func Diff(expected *trees.Markup, actual *trees.Markup) string {
+	path, want, got, ok := mismatch(expected, actual, nil, false)
+	if ok {
+		return ""
+	}
+
+	return fmt.Sprintf("mismatch at %s:\n%s", strings.Join(path, " > "), LineDiff(want, got))
+}
+
+// AssertEqual fails the test if the giving markup are not structurally equal,
+// reporting their difference.
+func AssertEqual(t testing.TB, expected *trees.Markup, actual *trees.Markup) {
+	t.Helper()
+
+	if diff := Diff(expected, actual); diff != "" {
+		t.Fatalf("markup does not match, %s", diff)
+	}
+}
+
+// AssertHTML fails the test if the markup parsed from the giving html is not
+// structurally equal to the markup, reporting their difference.
+func AssertHTML(t testing.TB, expected string, actual *trees.Markup) {
+	t.Helper()
+
+	parsed := trees.ParseTree(expected)
+	if len(parsed) != 1 {
+		want, got := Canonical(parsed...), Canonical(actual)
+		if want != got {
+			t.Fatalf("markup does not match html with %d roots:\n%s", len(parsed), LineDiff(want, got))
+		}
+
+		return
+	}
+
+	AssertEqual(t, parsed[0], actual)
+}
+
+// Snapshot compares the canonical form of the giving markup against the golden
+// file testdata/<name>.golden, failing the test with their difference if they
+// do not match. Running the tests with -treetest.update writes the golden file
+// out instead.
+func Snapshot(t testing.TB, name string, markup ...*trees.Markup) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	got := Canonical(markup...)
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unable to create snapshot directory: %s", err)
+		}
+
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("unable to write snapshot %q: %s", path, err)
+		}
+
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read snapshot %q, run the tests with -treetest.update to create it: %s", path, err)
+	}
+
+	if string(want) != got {
+		t.Fatalf("markup does not match snapshot %q, run the tests with -treetest.update to update it:\n%s", path, LineDiff(string(want), got))
+	}
+}
+
+//==============================================================================
+
+// mismatch walks the giving markup together, returning the path to the first
+// subtree which does not match along with the canonical forms of both.
+func mismatch(expected *trees.Markup, actual *trees.Markup, path []string, pre bool) ([]string, string, string, bool) {
+	path = append(path, label(expected, actual))
+
+	if expected == nil || actual == nil {
+		if expected == actual {
+			return path, "", "", true
+		}
+
+		return path, Canonical(nonNil(expected)...), Canonical(nonNil(actual)...), false
+	}
+
+	if header(expected, pre) != header(actual, pre) {
+		return path, Canonical(expected), Canonical(actual), false
+	}
+
+	pre = pre || preformatted[expected.Name()]
+
+	want, got := children(expected), children(actual)
+	if len(want) != len(got) {
+		return path, Canonical(expected), Canonical(actual), false
+	}
+
+	for index := range want {
+		if subpath, w, g, ok := mismatch(want[index], got[index], path, pre); !ok {
+			if len(subpath) > len(path) {
+				subpath[len(path)] = fmt.Sprintf("%s:nth-child(%d)", subpath[len(path)], index+1)
+			}
+
+			return subpath, w, g, false
+		}
+	}
+
+	return path, "", "", true
+}
+
+// label returns the name used for the giving markup in the path of a
+// mismatch.
+func label(expected *trees.Markup, actual *trees.Markup) string {
+	switch {
+	case expected != nil:
+		return nodeName(expected)
+	case actual != nil:
+		return nodeName(actual)
+	}
+
+	return "(none)"
+}
+
+// nodeName returns the tag name of an element or the kind of other nodes.
+func nodeName(e *trees.Markup) string {
+	switch e.Kind() {
+	case trees.TextNode:
+		return "#text"
+	case trees.CommentNode:
+		return "#comment"
+	case trees.DoctypeNode:
+		return "#doctype"
+	}
+
+	return e.Name()
+}
+
+// nonNil returns the giving markup as a list if not nil.
+func nonNil(e *trees.Markup) []*trees.Markup {
+	if e == nil {
+		return nil
+	}
+
+	return []*trees.Markup{e}
+}
+
+// children returns the children of the markup which are compared, skipping
+// removed markup and text holding only whitespace.
+func children(e *trees.Markup) []*trees.Markup {
+	var list []*trees.Markup
+
+	for _, child := range e.Children() {
+		if child.Removed() {
+			continue
+		}
+
+		if child.Kind() == trees.TextNode && !child.Raw() && strings.TrimSpace(child.TextContent()) == "" && !preformatted[e.Name()] {
+			continue
+		}
+
+		list = append(list, child)
+	}
+
+	return list
+}
+
+// writeCanonical writes the canonical form of the markup into the buffer.
+func writeCanonical(out *bytes.Buffer, e *trees.Markup, depth int, pre bool) {
+	if e == nil || e.Removed() {
+		return
+	}
+
+	indent := strings.Repeat("  ", depth)
+
+	out.WriteString(indent)
+	out.WriteString(header(e, pre))
+	out.WriteString("\n")
+
+	if e.Kind() != trees.ElementNode {
+		return
+	}
+
+	pre = pre || preformatted[e.Name()]
+
+	for _, child := range children(e) {
+		writeCanonical(out, child, depth+1, pre)
+	}
+
+	out.WriteString(indent)
+	out.WriteString("</" + e.Name() + ">\n")
+}
+
+// header returns the line describing the giving markup without its children,
+// which is the opening tag of elements and the content of other nodes.
+func header(e *trees.Markup, pre bool) string {
+	switch e.Kind() {
+	case trees.TextNode:
+		if e.Raw() {
+			return "raw " + fmt.Sprintf("%q", e.TextContent())
+		}
+
+		return quoteText(e.TextContent(), pre)
+	case trees.CommentNode:
+		return "<!--" + e.TextContent() + "-->"
+	case trees.DoctypeNode:
+		return "<!DOCTYPE " + e.TextContent() + ">"
+	}
+
+	var attrs, styles []string
+
+	for _, attr := range e.Attributes() {
+		name, value := attr.Render()
+		if ManagementAttributes[name] {
+			continue
+		}
+
+		// inline styles written as attributes are compared with those added
+		// through trees.CSSStyle.
+		if name == "style" {
+			styles = append(styles, splitStyles(value)...)
+			continue
+		}
+
+		if name == "class" {
+			classes := strings.Fields(value)
+			sort.Strings(classes)
+			value = strings.Join(classes, " ")
+		}
+
+		attrs = append(attrs, fmt.Sprintf("%s=%q", name, value))
+	}
+
+	for _, style := range e.Styles() {
+		name, value := style.Render()
+		styles = append(styles, name+": "+value)
+	}
+
+	if len(styles) != 0 {
+		sort.Strings(styles)
+		attrs = append(attrs, fmt.Sprintf("style=%q", strings.Join(styles, "; ")))
+	}
+
+	sort.Strings(attrs)
+
+	var text string
+	if content := e.TextContent(); content != "" {
+		text = " " + quoteText(content, pre || preformatted[e.Name()])
+	}
+
+	name := e.Name()
+	if namespace := e.Namespace(); namespace != "" {
+		name = namespace + ":" + name
+	}
+
+	if len(attrs) == 0 {
+		return "<" + name + ">" + text
+	}
+
+	return "<" + name + " " + strings.Join(attrs, " ") + ">" + text
+}
+
+// splitStyles returns the declarations of an inline style as 'name: value'.
+func splitStyles(style string) []string {
+	var styles []string
+
+	for _, declaration := range strings.Split(style, ";") {
+		parts := strings.SplitN(declaration, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		styles = append(styles, strings.TrimSpace(parts[0])+": "+strings.TrimSpace(parts[1]))
+	}
+
+	return styles
+}
+
+// quoteText returns the quoted text, with its whitespace collapsed unless
+// preformatted.
+func quoteText(text string, pre bool) string {
+	if !pre {
+		text = strings.Join(strings.Fields(text), " ")
+	}
+
+	return fmt.Sprintf("%q", text)
+}
+
+// Text returns the text within the giving markup and its children like the
+// textContent of the DOM, with its whitespace collapsed.
+func Text(markup ...*trees.Markup) string {
+	var parts []string
+
+	for _, item := range markup {
+		parts = append(parts, collectText(item)...)
+	}
+
+	return strings.Join(strings.Fields(strings.Join(parts, "")), " ")
+}
+
+// collectText returns the text of the markup and its children in order.
+func collectText(e *trees.Markup) []string {
+	if e == nil || e.Removed() {
+		return nil
+	}
+
+	switch e.Kind() {
+	case trees.CommentNode, trees.DoctypeNode:
+		return nil
+	case trees.TextNode:
+		return []string{e.TextContent()}
+	}
+
+	parts := []string{e.TextContent()}
+	for _, child := range e.Children() {
+		parts = append(parts, collectText(child)...)
+	}
+
+	return parts
+}
@@ -0,0 +1,118 @@
+package treetest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/treetest"
+	"github.com/influx6/faux/tests"
+)
+
+// recorder records the failures of assertions which are expected to fail.
+type recorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+func card(title string) *trees.Markup {
+	return elems.Div(
+		trees.NewAttr("id", "card"),
+		trees.NewAttr("class", "card active"),
+		trees.NewCSSStyle("color", "red"),
+		elems.Header1(elems.Text(title)),
+		elems.Paragraph(elems.Text("Body")),
+	)
+}
+
+func TestEqual(t *testing.T) {
+	parsed := trees.ParseTree(`
+		<div class="active card" style="color: red" id="card">
+			<h1>Hello</h1>
+			<p>
+				Body
+			</p>
+		</div>
+	`)
+
+	if !treetest.Equal(parsed[0], card("Hello")) {
+		tests.Failed("Should have ignored management attributes, ordering and whitespace: %s", treetest.Diff(parsed[0], card("Hello")))
+	}
+	tests.Passed("Should have ignored management attributes, ordering and whitespace")
+
+	diff := treetest.Diff(card("Hello"), card("World"))
+	if !strings.Contains(diff, "div > h1:nth-child(1) > #text:nth-child(1)") {
+		tests.Failed("Should have reported the path to the mismatching subtree: %s", diff)
+	}
+	tests.Passed("Should have reported the path to the mismatching subtree")
+
+	if !strings.Contains(diff, `- "Hello"`) || !strings.Contains(diff, `+ "World"`) {
+		tests.Failed("Should have reported the mismatching lines: %s", diff)
+	}
+	tests.Passed("Should have reported the mismatching lines")
+
+	missing := card("Hello")
+	missing.Children()[1].Remove()
+
+	if diff := treetest.Diff(card("Hello"), missing); !strings.Contains(diff, "-   <p>") {
+		tests.Failed("Should have reported the missing child: %s", diff)
+	}
+	tests.Passed("Should have reported the missing child")
+
+	pre := trees.ParseTree("<pre>a  b</pre>")[0]
+	if treetest.Equal(pre, trees.ParseTree("<pre>a b</pre>")[0]) {
+		tests.Failed("Should have kept the whitespace of preformatted text")
+	}
+	tests.Passed("Should have kept the whitespace of preformatted text")
+}
+
+func TestAssertHTML(t *testing.T) {
+	treetest.AssertHTML(t, `<div id="card" class="card active" style="color:red"><h1>Hello</h1><p>Body</p></div>`, card("Hello"))
+	tests.Passed("Should have matched the html")
+
+	var rec recorder
+	treetest.AssertHTML(&rec, `<div id="card"><h1>Hello</h1></div>`, card("Hello"))
+
+	if !strings.Contains(rec.failure, "mismatch at div") {
+		tests.Failed("Should have failed with the difference: %q", rec.failure)
+	}
+	tests.Passed("Should have failed with the difference")
+}
+
+func TestSnapshot(t *testing.T) {
+	treetest.Snapshot(t, "card", card("Hello"))
+	tests.Passed("Should have matched the snapshot")
+
+	var rec recorder
+	treetest.Snapshot(&rec, "card", card("World"))
+
+	if !strings.Contains(rec.failure, `+     "World"`) {
+		tests.Failed("Should have failed with the difference to the snapshot: %q", rec.failure)
+	}
+	tests.Passed("Should have failed with the difference to the snapshot")
+
+	rec.failure = ""
+	treetest.Snapshot(&rec, "missing", card("Hello"))
+
+	if !strings.Contains(rec.failure, "-treetest.update") {
+		tests.Failed("Should have failed for missing snapshots: %q", rec.failure)
+	}
+	tests.Passed("Should have failed for missing snapshots")
+}
+
+func TestText(t *testing.T) {
+	markup := trees.ParseTree(`<ul><li>One</li><li>Two <b>and</b>   Three</li></ul>`)
+
+	if text := treetest.Text(markup...); text != "OneTwo and Three" {
+		tests.Failed("Should have collected the text of the markup: %q", text)
+	}
+	tests.Passed("Should have collected the text of the markup")
+}